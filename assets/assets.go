@@ -0,0 +1,13 @@
+// Package assets holds static resources embedded directly into the binary,
+// so the app has no runtime dependency on files alongside it.
+package assets
+
+import _ "embed"
+
+// PlaceholderCoverPNG is a neutral gray placeholder shown in place of a
+// book's cover while the real one is loading, or in place of a cover that
+// failed to load, so the results list and details pane don't show ragged
+// blank space or frontend-specific fallback text.
+//
+//go:embed placeholder_cover.png
+var PlaceholderCoverPNG []byte
@@ -0,0 +1,277 @@
+// Package catalog persists every book the app has ever fetched - search
+// results and full details alike - to a local SQLite database, so past
+// results remain browsable offline.
+package catalog
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+// Store is a handle to the on-disk catalog database. It is safe for
+// concurrent use; *sql.DB already pools its own connections.
+type Store struct {
+	db *sql.DB
+}
+
+// Open opens (creating if necessary) the catalog database at path.
+func Open(path string) (*Store, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("catalog: open %s: %w", path, err)
+	}
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("catalog: create schema: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Close releases the underlying database handle.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+const schema = `
+CREATE TABLE IF NOT EXISTS books (
+	details_url  TEXT PRIMARY KEY,
+	source       TEXT NOT NULL,
+	title        TEXT NOT NULL,
+	author       TEXT,
+	year         TEXT,
+	language     TEXT,
+	format       TEXT,
+	size_text    TEXT,
+	rating       TEXT,
+	cover_url    TEXT,
+	description  TEXT,
+	publisher    TEXT,
+	isbn10       TEXT,
+	isbn13       TEXT,
+	download_url TEXT,
+	fetched_at   DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS recent_views (
+	book_id     TEXT PRIMARY KEY,
+	source      TEXT NOT NULL,
+	title       TEXT NOT NULL,
+	author      TEXT,
+	details_url TEXT NOT NULL,
+	cover_url   TEXT,
+	viewed_at   DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS downloads (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	downloaded_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+`
+
+// maxRecentViews caps how many entries RecordView keeps, oldest dropped
+// first.
+const maxRecentViews = 50
+
+// SaveSearchResult upserts a search result's summary fields into the
+// catalog, leaving any previously-saved detail fields for that book intact.
+func (s *Store) SaveSearchResult(r zlibrary.BookSearchResult) error {
+	_, err := s.db.Exec(`
+		INSERT INTO books (details_url, source, title, author, year, language, format, size_text, rating, cover_url)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(details_url) DO UPDATE SET
+			source=excluded.source, title=excluded.title, author=excluded.author,
+			year=excluded.year, language=excluded.language, format=excluded.format,
+			size_text=excluded.size_text, rating=excluded.rating, cover_url=excluded.cover_url,
+			fetched_at=CURRENT_TIMESTAMP
+	`, r.DetailsURL, r.Source, r.Title, r.Author, r.Year, r.Language, r.Format, r.SizeText, r.Rating, r.CoverURL)
+	if err != nil {
+		return fmt.Errorf("catalog: save search result: %w", err)
+	}
+	return nil
+}
+
+// SaveDetails upserts a book's full details into the catalog.
+func (s *Store) SaveDetails(d *zlibrary.BookDetails) error {
+	if err := s.SaveSearchResult(d.BookSearchResult); err != nil {
+		return err
+	}
+
+	_, err := s.db.Exec(`
+		UPDATE books SET description=?, publisher=?, isbn10=?, isbn13=?, download_url=?
+		WHERE details_url=?
+	`, d.Description, d.Publisher, d.ISBN10, d.ISBN13, d.DownloadURL, d.DetailsURL)
+	if err != nil {
+		return fmt.Errorf("catalog: save details: %w", err)
+	}
+	return nil
+}
+
+// GetDetails returns the catalog's cached copy of a book's details, for
+// offline-first fallback when a live fetch fails. It reports sql.ErrNoRows
+// (wrapped) if detailsURL has never been saved via SaveDetails, or was only
+// ever seen as a bare search result, since that leaves description,
+// publisher, and the ISBN columns blank.
+func (s *Store) GetDetails(detailsURL string) (*zlibrary.BookDetails, error) {
+	var d zlibrary.BookDetails
+	row := s.db.QueryRow(`
+		SELECT details_url, source, title, author, year, language, format, size_text, rating, cover_url,
+			description, publisher, isbn10, isbn13, download_url
+		FROM books WHERE details_url = ? AND description IS NOT NULL
+	`, detailsURL)
+
+	err := row.Scan(
+		&d.DetailsURL, &d.Source, &d.Title, &d.Author, &d.Year, &d.Language, &d.Format, &d.SizeText, &d.Rating, &d.CoverURL,
+		&d.Description, &d.Publisher, &d.ISBN10, &d.ISBN13, &d.DownloadURL,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("catalog: get details: %w", err)
+	}
+	return &d, nil
+}
+
+// Search returns catalog books whose title, author, ISBN-10, or ISBN-13
+// contains query, case-insensitively, without touching the network. Title
+// matches are ranked ahead of author/ISBN-only matches; ties break by most
+// recently fetched. There is no "series" column in the schema yet, so that
+// part of a query is not matched against anything.
+func (s *Store) Search(query string) ([]zlibrary.BookSearchResult, error) {
+	like := "%" + strings.ToLower(query) + "%"
+	rows, err := s.db.Query(`
+		SELECT details_url, source, title, author, year, language, format, size_text, rating, cover_url
+		FROM books
+		WHERE LOWER(title) LIKE ? OR LOWER(author) LIKE ? OR LOWER(isbn10) LIKE ? OR LOWER(isbn13) LIKE ?
+		ORDER BY
+			CASE WHEN LOWER(title) LIKE ? THEN 0 ELSE 1 END,
+			fetched_at DESC
+	`, like, like, like, like, like)
+	if err != nil {
+		return nil, fmt.Errorf("catalog: search: %w", err)
+	}
+	defer rows.Close()
+
+	var results []zlibrary.BookSearchResult
+	for rows.Next() {
+		var r zlibrary.BookSearchResult
+		if err := rows.Scan(&r.DetailsURL, &r.Source, &r.Title, &r.Author, &r.Year, &r.Language, &r.Format, &r.SizeText, &r.Rating, &r.CoverURL); err != nil {
+			return nil, fmt.Errorf("catalog: scan: %w", err)
+		}
+		results = append(results, r)
+	}
+	return results, rows.Err()
+}
+
+// RecordView upserts r into the recently-viewed list, moving it to the top
+// if it was already there rather than adding a duplicate entry, then trims
+// the list down to maxRecentViews. Books with no ID (nothing stable to
+// dedupe by) are silently skipped.
+func (s *Store) RecordView(r zlibrary.BookSearchResult) error {
+	if r.ID == "" {
+		return nil
+	}
+
+	_, err := s.db.Exec(`
+		INSERT INTO recent_views (book_id, source, title, author, details_url, cover_url, viewed_at)
+		VALUES (?, ?, ?, ?, ?, ?, strftime('%Y-%m-%d %H:%M:%f', 'now'))
+		ON CONFLICT(book_id) DO UPDATE SET
+			source=excluded.source, title=excluded.title, author=excluded.author,
+			details_url=excluded.details_url, cover_url=excluded.cover_url, viewed_at=excluded.viewed_at
+	`, r.ID, r.Source, r.Title, r.Author, r.DetailsURL, r.CoverURL)
+	if err != nil {
+		return fmt.Errorf("catalog: record view: %w", err)
+	}
+
+	_, err = s.db.Exec(`
+		DELETE FROM recent_views WHERE book_id NOT IN (
+			SELECT book_id FROM recent_views ORDER BY viewed_at DESC LIMIT ?
+		)
+	`, maxRecentViews)
+	if err != nil {
+		return fmt.Errorf("catalog: trim recent views: %w", err)
+	}
+	return nil
+}
+
+// RecentViews returns the recently-viewed books list, newest first.
+func (s *Store) RecentViews() ([]zlibrary.BookSearchResult, error) {
+	rows, err := s.db.Query(`
+		SELECT book_id, source, title, author, details_url, cover_url
+		FROM recent_views ORDER BY viewed_at DESC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("catalog: recent views: %w", err)
+	}
+	defer rows.Close()
+
+	var results []zlibrary.BookSearchResult
+	for rows.Next() {
+		var r zlibrary.BookSearchResult
+		if err := rows.Scan(&r.ID, &r.Source, &r.Title, &r.Author, &r.DetailsURL, &r.CoverURL); err != nil {
+			return nil, fmt.Errorf("catalog: scan: %w", err)
+		}
+		results = append(results, r)
+	}
+	return results, rows.Err()
+}
+
+// RemoveRecentView drops bookID from the recently-viewed list, for a stale
+// entry whose details page no longer exists.
+func (s *Store) RemoveRecentView(bookID string) error {
+	_, err := s.db.Exec(`DELETE FROM recent_views WHERE book_id = ?`, bookID)
+	if err != nil {
+		return fmt.Errorf("catalog: remove recent view: %w", err)
+	}
+	return nil
+}
+
+// RecordDownload logs a single completed download against the current
+// time, for CountSince to tally later. It deliberately carries no other
+// detail (no book, no format) - the counter only needs to answer "how many
+// today", not "which ones".
+func (s *Store) RecordDownload() error {
+	_, err := s.db.Exec(`INSERT INTO downloads DEFAULT VALUES`)
+	if err != nil {
+		return fmt.Errorf("catalog: record download: %w", err)
+	}
+	return nil
+}
+
+// CountSince returns how many downloads RecordDownload has logged at or
+// after t, for pacing against a site's daily download limit.
+func (s *Store) CountSince(t time.Time) (int, error) {
+	var n int
+	err := s.db.QueryRow(`SELECT COUNT(*) FROM downloads WHERE downloaded_at >= ?`, t.UTC()).Scan(&n)
+	if err != nil {
+		return 0, fmt.Errorf("catalog: count downloads: %w", err)
+	}
+	return n, nil
+}
+
+// All returns every book in the catalog, most recently fetched first.
+func (s *Store) All() ([]zlibrary.BookSearchResult, error) {
+	rows, err := s.db.Query(`
+		SELECT details_url, source, title, author, year, language, format, size_text, rating, cover_url
+		FROM books ORDER BY fetched_at DESC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("catalog: list: %w", err)
+	}
+	defer rows.Close()
+
+	var results []zlibrary.BookSearchResult
+	for rows.Next() {
+		var r zlibrary.BookSearchResult
+		if err := rows.Scan(&r.DetailsURL, &r.Source, &r.Title, &r.Author, &r.Year, &r.Language, &r.Format, &r.SizeText, &r.Rating, &r.CoverURL); err != nil {
+			return nil, fmt.Errorf("catalog: scan: %w", err)
+		}
+		results = append(results, r)
+	}
+	return results, rows.Err()
+}
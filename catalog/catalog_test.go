@@ -0,0 +1,53 @@
+package catalog
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+func TestSearchRanksTitleMatchesFirst(t *testing.T) {
+	store, err := Open(filepath.Join(t.TempDir(), "catalog.db"))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer store.Close()
+
+	store.SaveSearchResult(zlibrary.BookSearchResult{DetailsURL: "/book/1", Title: "Unrelated Novel", Author: "Dune Enjoyer"})
+	store.SaveSearchResult(zlibrary.BookSearchResult{DetailsURL: "/book/2", Title: "Dune", Author: "Frank Herbert"})
+
+	results, err := store.Search("dune")
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	if results[0].Title != "Dune" {
+		t.Errorf("first result = %q, want the title match ranked first", results[0].Title)
+	}
+}
+
+func TestRecordViewDedupesAndReordersByRevisit(t *testing.T) {
+	store, err := Open(filepath.Join(t.TempDir(), "catalog.db"))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer store.Close()
+
+	store.RecordView(zlibrary.BookSearchResult{ID: "1", DetailsURL: "/book/1", Title: "First"})
+	store.RecordView(zlibrary.BookSearchResult{ID: "2", DetailsURL: "/book/2", Title: "Second"})
+	store.RecordView(zlibrary.BookSearchResult{ID: "1", DetailsURL: "/book/1", Title: "First"})
+
+	recent, err := store.RecentViews()
+	if err != nil {
+		t.Fatalf("RecentViews: %v", err)
+	}
+	if len(recent) != 2 {
+		t.Fatalf("got %d entries, want 2 (revisit should not duplicate)", len(recent))
+	}
+	if recent[0].ID != "1" {
+		t.Errorf("first entry = %q, want the revisited book moved to the top", recent[0].ID)
+	}
+}
@@ -0,0 +1,287 @@
+// Package config handles loading, defaulting, and persisting user
+// preferences for The Blackbook Archive.
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Advik-B/The-Blackbook-Archive/utils"
+)
+
+// Config holds all user-configurable settings for the application.
+type Config struct {
+	DownloadDir string `json:"download_dir"`
+
+	// EnableEnrichment turns on the optional Open Library metadata lookup
+	// for book details that are missing fields.
+	EnableEnrichment bool `json:"enable_enrichment"`
+
+	// DefaultSource is the name of the Provider a new search uses, e.g.
+	// "Z-Library" or "Library Genesis".
+	DefaultSource string `json:"default_source"`
+
+	// MaxDownloadSizeBytes rejects any download larger than this. Zero
+	// means unlimited.
+	MaxDownloadSizeBytes int64 `json:"max_download_size_bytes"`
+
+	// MaxDownloadBytesPerSec caps average download throughput, shared
+	// across all downloads since there is one Manager per app. Zero means
+	// unlimited.
+	MaxDownloadBytesPerSec int64 `json:"max_download_bytes_per_sec"`
+
+	// ConversionToolPath is the local ebook conversion tool to shell out
+	// to (e.g. Calibre's "ebook-convert"). Empty uses the default.
+	ConversionToolPath string `json:"conversion_tool_path"`
+
+	// ThumbnailSize is the square side, in pixels, that cover thumbnails
+	// are rendered at in the results list.
+	ThumbnailSize int `json:"thumbnail_size"`
+
+	// DebugMode, when set, saves the full body of any failed scrape
+	// request under DebugSnapshotPath for later inspection, rather than
+	// only the bounded snippet normal error messages carry.
+	DebugMode bool `json:"debug_mode"`
+
+	// UserAgent overrides the default User-Agent sent to Z-Library. Empty
+	// keeps the client's built-in default. Ignored when RotateUserAgent
+	// is set.
+	UserAgent string `json:"user_agent"`
+
+	// RotateUserAgent picks a random browser profile once per session
+	// instead of using UserAgent or the built-in default.
+	RotateUserAgent bool `json:"rotate_user_agent"`
+
+	// MaxCoverImageBytes caps how much of a cover image response is read
+	// into memory. Zero falls back to a 10 MB default.
+	MaxCoverImageBytes int64 `json:"max_cover_image_bytes"`
+
+	// ImageFetchTimeoutSeconds bounds how long a single cover image fetch
+	// may take. Zero falls back to a 10 second default.
+	ImageFetchTimeoutSeconds int `json:"image_fetch_timeout_seconds"`
+
+	// SafeMode strips known tracking/session query parameters from every
+	// cover, download, and author URL the scraper resolves.
+	SafeMode bool `json:"safe_mode"`
+
+	// IPFSGateways overrides the gateways used to resolve a book's IPFS
+	// CID into a fetchable URL, in preference order. Empty uses the
+	// client's built-in default gateway.
+	IPFSGateways []string `json:"ipfs_gateways"`
+
+	// HTTPDump, when set, logs every request/response exchange (not just
+	// failed ones) to HTTPDumpPath for attaching to a bug report.
+	HTTPDump bool `json:"http_dump"`
+
+	// PreferredFormats ranks download formats (e.g. "EPUB", "PDF"),
+	// most-preferred first. The download flow picks the highest-ranked
+	// format actually available for a book, falling back to its primary
+	// format when none of these are offered. Empty disables the feature.
+	PreferredFormats []string `json:"preferred_formats"`
+
+	// ZLibraryBaseURL overrides the mirror the Z-Library client talks to.
+	// Empty uses the client's built-in default.
+	ZLibraryBaseURL string `json:"zlibrary_base_url"`
+
+	// ByteFormatDecimal renders sizes with 1000-based (SI) units instead of
+	// the default 1024-based ones.
+	ByteFormatDecimal bool `json:"byte_format_decimal"`
+
+	// ByteFormatIEC renders binary sizes with full IEC suffixes ("MiB")
+	// instead of the default short ones ("MB"). Ignored when
+	// ByteFormatDecimal is set.
+	ByteFormatIEC bool `json:"byte_format_iec"`
+
+	// MaxConcurrentImageFetches caps how many cover fetches (thumbnails and
+	// the details-pane cover alike) run at once. Zero falls back to a
+	// default of 4. Fetches beyond the limit queue rather than being
+	// dropped.
+	MaxConcurrentImageFetches int `json:"max_concurrent_image_fetches"`
+
+	// PostDownloadHookEnabled turns on running PostDownloadHookCommand after
+	// each successful download. Off by default - this shells out to a local
+	// tool, so it's opt-in.
+	PostDownloadHookEnabled bool `json:"post_download_hook_enabled"`
+
+	// PostDownloadHookCommand is a command template run after each
+	// successful download, e.g. `calibredb add "{path}"`. Supported
+	// placeholders: {path}, {title}, {author}, {format}.
+	PostDownloadHookCommand string `json:"post_download_hook_command"`
+
+	// PostDownloadHookTimeoutSeconds bounds how long the hook command may
+	// run. Zero falls back to a 30 second default.
+	PostDownloadHookTimeoutSeconds int `json:"post_download_hook_timeout_seconds"`
+
+	// DailyDownloadSoftLimit is the download count, independent of whatever
+	// the site itself enforces, past which the app nags: the download
+	// button gets a warning tooltip and bulk actions ask for confirmation.
+	// Zero disables the nag entirely.
+	DailyDownloadSoftLimit int `json:"daily_download_soft_limit"`
+
+	// DownloadCounterUTC keys the daily download counter's "day" boundary
+	// to UTC instead of the local system time zone.
+	DownloadCounterUTC bool `json:"download_counter_utc"`
+
+	// OpenAfterDownload opens a file in the system's default application
+	// as soon as its download finishes successfully. Off by default.
+	OpenAfterDownload bool `json:"open_after_download"`
+
+	// EnableMirrorRacing races the primary mirror against the first entry
+	// of MirrorCandidates for every search, using whichever responds
+	// first. Off by default to avoid doubling request load for users with
+	// a healthy connection to the primary.
+	EnableMirrorRacing bool `json:"enable_mirror_racing"`
+
+	// MirrorCandidates lists alternate Z-Library mirrors SearchZLibrary
+	// may race the primary against. Only the first entry is currently
+	// used.
+	MirrorCandidates []string `json:"mirror_candidates"`
+
+	// Language sets the Accept-Language header and the site's interface-
+	// language cookie sent with every request. Blank leaves the client's
+	// OS-locale-derived default in place.
+	Language string `json:"language"`
+
+	// EnableLazyEnrichment turns on a background worker that fetches
+	// format/size details for loaded results that don't already have
+	// them, paced well below what clicking through results by hand would
+	// generate. Off by default since it multiplies request volume.
+	EnableLazyEnrichment bool `json:"enable_lazy_enrichment"`
+
+	// AutoDiscoverMirrors consents to running DiscoverMirrors automatically
+	// once the configured mirror looks unreachable, instead of only ever
+	// probing when the user clicks "Find working mirror" themselves.
+	AutoDiscoverMirrors bool `json:"auto_discover_mirrors"`
+
+	// DiscoveredMirrors caches the last successful DiscoverMirrors run,
+	// fastest first, alongside DiscoveredMirrorsAt so a later run - whether
+	// automatic or button-triggered - can be skipped in favor of the cached
+	// list while it's still fresh.
+	DiscoveredMirrors   []string  `json:"discovered_mirrors"`
+	DiscoveredMirrorsAt time.Time `json:"discovered_mirrors_at"`
+
+	// IncludeAuthorInFilenames appends the primary author - normalized via
+	// utils.NormalizeAuthor - to a download's generated filename, as
+	// "Title - Author.ext" instead of just "Title.ext". On by default;
+	// turn it off to go back to title-only filenames.
+	IncludeAuthorInFilenames bool `json:"include_author_in_filenames"`
+
+	// SkipOverwriteConfirm disables the "click again to confirm" prompt a
+	// single-file download shows when its destination path already
+	// exists, for a user who'd rather downloads just always overwrite.
+	SkipOverwriteConfirm bool `json:"skip_overwrite_confirm"`
+
+	// PreferSmallerEditions makes the grouped-editions results view default
+	// to the smallest file among a group's editions instead of whichever
+	// one the search results happened to list first. Off by default.
+	PreferSmallerEditions bool `json:"prefer_smaller_editions"`
+
+	// TransliterateNonLatinTitles romanizes a Cyrillic, Japanese, or
+	// Devanagari title (and author, if included) via utils.Transliterate
+	// before it's used to build a download's filename, so it survives
+	// filesystems and sync tools that mangle non-Latin names. The
+	// original title is never touched anywhere else - sidecar metadata
+	// and history always keep the scraped text as-is. Off by default.
+	TransliterateNonLatinTitles bool `json:"transliterate_non_latin_titles"`
+}
+
+// ByteFormatOptions returns the utils.FormatOptions matching c's configured
+// byte-formatting style, for passing to utils.FormatBytesWith.
+func (c *Config) ByteFormatOptions() utils.FormatOptions {
+	return utils.FormatOptions{Decimal: c.ByteFormatDecimal, IEC: c.ByteFormatIEC}
+}
+
+// Default returns a Config populated with sensible defaults.
+func Default() *Config {
+	home, _ := os.UserHomeDir()
+	return &Config{
+		DownloadDir:              filepath.Join(home, "Downloads", "BlackbookArchive"),
+		EnableEnrichment:         true,
+		DefaultSource:            "Z-Library",
+		ThumbnailSize:            96,
+		MaxCoverImageBytes:       10 << 20,
+		ImageFetchTimeoutSeconds: 10,
+		IncludeAuthorInFilenames: true,
+	}
+}
+
+// Path returns the on-disk location of the config file.
+func Path() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "blackbook-archive", "config.json"), nil
+}
+
+// CatalogPath returns the on-disk location of the local SQLite catalog,
+// alongside the config file.
+func CatalogPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "blackbook-archive", "catalog.db"), nil
+}
+
+// DebugSnapshotPath returns the directory failed-request bodies are saved
+// to when DebugMode is enabled, alongside the config file.
+func DebugSnapshotPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "blackbook-archive", "debug-snapshots"), nil
+}
+
+// HTTPDumpPath returns the directory every request/response exchange is
+// logged to when HTTPDump is enabled, alongside the config file.
+func HTTPDumpPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "blackbook-archive", "http-dump"), nil
+}
+
+// Load reads the config file from disk, falling back to Default if it does
+// not yet exist.
+func Load() (*Config, error) {
+	path, err := Path()
+	if err != nil {
+		return Default(), err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return Default(), nil
+	}
+	if err != nil {
+		return Default(), err
+	}
+
+	cfg := Default()
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return Default(), err
+	}
+	return cfg, nil
+}
+
+// Save writes the config to disk, creating its parent directory if needed.
+func (c *Config) Save() error {
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
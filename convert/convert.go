@@ -0,0 +1,45 @@
+// Package convert shells out to an external tool to convert a downloaded
+// book between formats locally, for when a site offers a book only as
+// EPUB (or similar) but the user wants something else.
+package convert
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+)
+
+// defaultTool is Calibre's command-line converter, the most commonly
+// installed tool capable of this.
+const defaultTool = "ebook-convert"
+
+// Converter shells out to a local ebook conversion tool.
+type Converter struct {
+	toolPath string
+}
+
+// NewConverter returns a Converter that invokes toolPath. An empty
+// toolPath uses the default "ebook-convert" found on PATH.
+func NewConverter(toolPath string) *Converter {
+	if toolPath == "" {
+		toolPath = defaultTool
+	}
+	return &Converter{toolPath: toolPath}
+}
+
+// Available reports whether the configured conversion tool can be found.
+func (c *Converter) Available() bool {
+	_, err := exec.LookPath(c.toolPath)
+	return err == nil
+}
+
+// Convert converts srcPath to destPath, inferring both formats from their
+// file extensions, as ebook-convert does.
+func (c *Converter) Convert(ctx context.Context, srcPath, destPath string) error {
+	cmd := exec.CommandContext(ctx, c.toolPath, srcPath, destPath)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("convert: %s: %w: %s", c.toolPath, err, output)
+	}
+	return nil
+}
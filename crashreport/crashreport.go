@@ -0,0 +1,35 @@
+// Package crashreport recovers from panics that would otherwise kill the
+// app silently, writing what happened to a timestamped file so it can be
+// attached to a bug report.
+package crashreport
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"time"
+)
+
+// Recover should be deferred at the top of main. If the function it
+// guards panics, Recover writes the panic value and stack trace to a
+// crash report file under dir and re-panics so the process still exits
+// non-zero.
+func Recover(dir string) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("crash-%s.log", time.Now().Format("20060102-150405")))
+	os.MkdirAll(dir, 0o755)
+
+	report := fmt.Sprintf("panic: %v\n\n%s", r, debug.Stack())
+	if err := os.WriteFile(path, []byte(report), 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "crashreport: also failed to write %s: %v\n", path, err)
+	} else {
+		fmt.Fprintf(os.Stderr, "crashreport: wrote %s\n", path)
+	}
+
+	panic(r)
+}
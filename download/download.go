@@ -0,0 +1,129 @@
+// Package download executes the *http.Request objects built by a
+// source.Provider, streaming the response to disk under the app's
+// configured limits.
+package download
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync/atomic"
+
+	"github.com/Advik-B/The-Blackbook-Archive/utils"
+)
+
+// ErrTooLarge is returned when a download's size - known up front via
+// Content-Length, or discovered mid-stream - exceeds the configured guard.
+var ErrTooLarge = fmt.Errorf("download: file exceeds the configured maximum size")
+
+// Manager executes downloads on behalf of the app, enforcing a maximum
+// file size so a single oversized or misidentified file can't silently
+// fill the user's disk. There is normally one Manager shared by the whole
+// app, so its throttle (see SetMaxDownloadBytesPerSec) is effectively a
+// global cap across all downloads it runs, not a per-download one.
+type Manager struct {
+	httpClient *http.Client
+	maxBytes   int64 // 0 means unlimited
+
+	// maxBytesPerSec is read by every in-flight download's throttledReader
+	// on each chunk, so changing it takes effect on in-flight downloads
+	// immediately rather than only on the next one started.
+	maxBytesPerSec int64 // atomic; 0 means unlimited
+}
+
+// NewManager returns a Manager that rejects downloads larger than
+// maxBytes. A maxBytes of 0 disables the guard.
+func NewManager(maxBytes int64) *Manager {
+	return &Manager{
+		httpClient: http.DefaultClient,
+		maxBytes:   maxBytes,
+	}
+}
+
+// SetMaxBytes updates the size guard at runtime.
+func (m *Manager) SetMaxBytes(maxBytes int64) {
+	m.maxBytes = maxBytes
+}
+
+// SetMaxDownloadBytesPerSec caps the average throughput of downloads this
+// Manager runs. 0 disables the cap. Takes effect immediately, including on
+// downloads already in progress.
+func (m *Manager) SetMaxDownloadBytesPerSec(bytesPerSec int64) {
+	atomic.StoreInt64(&m.maxBytesPerSec, bytesPerSec)
+}
+
+// Preflight reports the size and content type a download would have,
+// without fetching the body, by sending a HEAD request for the same URL.
+// Some servers don't support HEAD and return sizeless/typeless results;
+// callers should treat a zero size as "unknown", not "empty".
+type Preflight struct {
+	SizeBytes   int64
+	ContentType string
+}
+
+// PreflightRequest issues a HEAD request derived from req to learn a
+// download's size and type before committing to fetching it.
+func (m *Manager) PreflightRequest(ctx context.Context, req *http.Request) (Preflight, error) {
+	headReq := req.Clone(ctx)
+	headReq.Method = http.MethodHead
+
+	resp, err := m.httpClient.Do(headReq)
+	if err != nil {
+		return Preflight{}, fmt.Errorf("download: preflight: %w", err)
+	}
+	defer resp.Body.Close()
+
+	size := resp.ContentLength
+	if size < 0 {
+		size = 0
+	}
+
+	return Preflight{
+		SizeBytes:   size,
+		ContentType: resp.Header.Get("Content-Type"),
+	}, nil
+}
+
+// Download executes req and writes its body to destPath, refusing to
+// start (or aborting mid-stream) if the response exceeds the configured
+// maximum size.
+func (m *Manager) Download(req *http.Request, destPath string) error {
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("download: request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("download: server returned %s: %s", resp.Status, utils.ReadSnippet(resp.Body, utils.DefaultSnippetBytes))
+	}
+
+	if m.maxBytes > 0 && resp.ContentLength > 0 && resp.ContentLength > m.maxBytes {
+		return ErrTooLarge
+	}
+
+	out, err := os.Create(destPath)
+	if err != nil {
+		return fmt.Errorf("download: create %s: %w", destPath, err)
+	}
+	defer out.Close()
+
+	body := io.Reader(resp.Body)
+	body = newThrottledReader(body, &m.maxBytesPerSec)
+	if m.maxBytes > 0 {
+		body = io.LimitReader(body, m.maxBytes+1)
+	}
+
+	written, err := io.Copy(out, body)
+	if err != nil {
+		return fmt.Errorf("download: write %s: %w", destPath, err)
+	}
+	if m.maxBytes > 0 && written > m.maxBytes {
+		os.Remove(destPath)
+		return ErrTooLarge
+	}
+
+	return nil
+}
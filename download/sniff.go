@@ -0,0 +1,95 @@
+package download
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ErrFormatMismatch is returned by VerifyFormat when a downloaded file's
+// magic bytes identify it as a format other than the one the caller
+// expected - most often a mirror silently substituting EPUB for a PDF it
+// doesn't actually have. An HTML page is not a recognized format, so it
+// does not produce this error.
+var ErrFormatMismatch = errors.New("download: downloaded file does not match the expected format")
+
+// sniffHeaderBytes is how much of a file VerifyFormat reads to identify
+// it. Large enough to cover every magic number below, including MOBI's at
+// offset 60.
+const sniffHeaderBytes = 68
+
+// SniffFormat identifies the format of the file at path from its magic
+// bytes, returning its canonical name (e.g. "EPUB", "PDF") and true, or
+// ("", false) if the header doesn't match any format this package knows
+// how to recognize. An unrecognized header is not itself suspicious - it
+// just means the result can't be used to confirm or refute an expected
+// format.
+func SniffFormat(path string) (string, bool) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", false
+	}
+	defer f.Close()
+
+	header := make([]byte, sniffHeaderBytes)
+	n, _ := f.Read(header)
+	header = header[:n]
+
+	return sniffHeader(header)
+}
+
+func sniffHeader(header []byte) (string, bool) {
+	switch {
+	case bytes.HasPrefix(header, []byte("%PDF-")):
+		return "PDF", true
+	case len(header) >= 64 && bytes.Equal(header[60:min(68, len(header))], []byte("BOOKMOBI")):
+		return "MOBI", true
+	case bytes.HasPrefix(header, []byte("AT&TFORM")):
+		return "DJVU", true
+	case bytes.HasPrefix(header, []byte("PK\x03\x04")):
+		if looksLikeEPUB(header) {
+			return "EPUB", true
+		}
+		return "ZIP", true
+	case bytes.HasPrefix(bytes.TrimLeft(header, "\xef\xbb\xbf \t\r\n"), []byte("<?xml")),
+		bytes.HasPrefix(bytes.TrimLeft(header, "\xef\xbb\xbf \t\r\n"), []byte("<FictionBook")):
+		return "FB2", true
+	default:
+		return "", false
+	}
+}
+
+// looksLikeEPUB reports whether a zip file's header is immediately
+// followed by the "mimetype" entry EPUB requires to be its first, stored
+// (uncompressed) member - the one structural marker that reliably tells an
+// EPUB apart from an arbitrary zip archive without fully parsing it.
+func looksLikeEPUB(header []byte) bool {
+	return bytes.Contains(header, []byte("mimetypeapplication/epub+zip"))
+}
+
+// VerifyFormat checks that the file at path's actual content matches
+// expectedFormat (case-insensitive, e.g. "epub", "PDF"), returning
+// ErrFormatMismatch naming the format that was actually detected if not.
+// A header VerifyFormat can't identify is not treated as a mismatch - only
+// a confident, different identification is.
+func VerifyFormat(path, expectedFormat string) error {
+	detected, ok := SniffFormat(path)
+	if !ok {
+		return nil
+	}
+
+	expected := strings.ToUpper(strings.TrimSpace(expectedFormat))
+	if expected == "" || detected == expected {
+		return nil
+	}
+
+	// AZW3 and MOBI share the same on-disk header; treat them as
+	// equivalent rather than flagging every AZW3 download as mismatched.
+	if detected == "MOBI" && expected == "AZW3" {
+		return nil
+	}
+
+	return fmt.Errorf("%w: expected %s, got %s", ErrFormatMismatch, expected, detected)
+}
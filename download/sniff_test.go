@@ -0,0 +1,74 @@
+package download
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTempFile(t *testing.T, data []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "downloaded")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	return path
+}
+
+func TestSniffFormatRecognizesKnownHeaders(t *testing.T) {
+	cases := []struct {
+		name   string
+		header []byte
+		want   string
+	}{
+		{"pdf", []byte("%PDF-1.7\n..."), "PDF"},
+		{"epub", append([]byte("PK\x03\x04"), []byte("mimetypeapplication/epub+zip")...), "EPUB"},
+		{"plain zip", []byte("PK\x03\x04 not epub at all here"), "ZIP"},
+		{"mobi", append(make([]byte, 60), []byte("BOOKMOBI")...), "MOBI"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			path := writeTempFile(t, c.header)
+			got, ok := SniffFormat(path)
+			if !ok || got != c.want {
+				t.Errorf("SniffFormat() = (%q, %v), want (%q, true)", got, ok, c.want)
+			}
+		})
+	}
+}
+
+func TestSniffFormatUnknownHeaderIsNotRecognized(t *testing.T) {
+	path := writeTempFile(t, []byte("just some text"))
+	if _, ok := SniffFormat(path); ok {
+		t.Error("expected an unrecognized header to report ok=false")
+	}
+}
+
+func TestVerifyFormatMismatch(t *testing.T) {
+	path := writeTempFile(t, []byte("%PDF-1.7\n..."))
+
+	if err := VerifyFormat(path, "EPUB"); !errors.Is(err, ErrFormatMismatch) {
+		t.Errorf("VerifyFormat() = %v, want ErrFormatMismatch", err)
+	}
+	if err := VerifyFormat(path, "PDF"); err != nil {
+		t.Errorf("VerifyFormat() = %v, want nil for a matching format", err)
+	}
+}
+
+func TestVerifyFormatTreatsAZW3AsMOBIEquivalent(t *testing.T) {
+	header := append(make([]byte, 60), []byte("BOOKMOBI")...)
+	path := writeTempFile(t, header)
+
+	if err := VerifyFormat(path, "AZW3"); err != nil {
+		t.Errorf("VerifyFormat() = %v, want nil (MOBI/AZW3 share a header)", err)
+	}
+}
+
+func TestVerifyFormatIgnoresUnrecognizedHeader(t *testing.T) {
+	path := writeTempFile(t, []byte("plain text ebook, no magic bytes"))
+	if err := VerifyFormat(path, "TXT"); err != nil {
+		t.Errorf("VerifyFormat() = %v, want nil for an unrecognized header", err)
+	}
+}
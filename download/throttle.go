@@ -0,0 +1,44 @@
+package download
+
+import (
+	"io"
+	"sync/atomic"
+	"time"
+)
+
+// throttledReader wraps an io.Reader with a simple token-bucket rate limit:
+// after each read it sleeps just long enough that the average throughput
+// since the reader was created stays at or below the configured limit.
+// limit is read fresh on every call (via the pointer into Manager), so
+// changing the Manager's cap affects a download already in progress.
+type throttledReader struct {
+	r     io.Reader
+	limit *int64 // atomic; bytes per second, 0 means unlimited
+
+	start time.Time
+	read  int64
+}
+
+func newThrottledReader(r io.Reader, limit *int64) *throttledReader {
+	return &throttledReader{r: r, limit: limit, start: time.Now()}
+}
+
+func (t *throttledReader) Read(p []byte) (int, error) {
+	n, err := t.r.Read(p)
+	if n <= 0 {
+		return n, err
+	}
+
+	limit := atomic.LoadInt64(t.limit)
+	if limit <= 0 {
+		return n, err
+	}
+
+	t.read += int64(n)
+	wantElapsed := time.Duration(float64(t.read) / float64(limit) * float64(time.Second))
+	if actualElapsed := time.Since(t.start); wantElapsed > actualElapsed {
+		time.Sleep(wantElapsed - actualElapsed)
+	}
+
+	return n, err
+}
@@ -0,0 +1,39 @@
+package download
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestThrottledReaderRespectsLimit(t *testing.T) {
+	data := bytes.Repeat([]byte("a"), 1000)
+	var limit int64 = 2000 // bytes/sec
+
+	start := time.Now()
+	r := newThrottledReader(bytes.NewReader(data), &limit)
+	if _, err := io.ReadAll(r); err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+
+	// 1000 bytes at 2000 bytes/sec should take at least ~500ms.
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("throttled read finished in %s, expected at least ~500ms", elapsed)
+	}
+}
+
+func TestThrottledReaderUnlimited(t *testing.T) {
+	data := bytes.Repeat([]byte("a"), 1000)
+	var limit int64 // 0 means unlimited
+
+	start := time.Now()
+	r := newThrottledReader(bytes.NewReader(data), &limit)
+	if _, err := io.ReadAll(r); err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("unlimited read took %s, expected near-instant", elapsed)
+	}
+}
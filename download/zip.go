@@ -0,0 +1,104 @@
+package download
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// ZipItem is one file to fetch and include in a zip bundle built by
+// DownloadAllToZip - an HTTP request and the name it should be stored under
+// inside the zip.
+type ZipItem struct {
+	Request  *http.Request
+	Filename string
+}
+
+type bundledFile struct {
+	path string
+	name string
+}
+
+// DownloadAllToZip fetches every item, each into its own file in a
+// temporary directory, then streams them all into a single zip written to
+// zipPath. An item that fails to download is skipped rather than aborting
+// the whole bundle; its error is joined into the returned error once every
+// item has been attempted, alongside the others, as long as at least one
+// item succeeded. progress, if non-nil, is called after each item
+// (successful or not) with the running count and the total. The temporary
+// directory is always removed, whether the bundle succeeds or not.
+func (m *Manager) DownloadAllToZip(ctx context.Context, items []ZipItem, zipPath string, progress func(done, total int)) error {
+	if len(items) == 0 {
+		return fmt.Errorf("download: no formats to bundle")
+	}
+
+	tmpDir, err := os.MkdirTemp("", "blackbook-bundle-*")
+	if err != nil {
+		return fmt.Errorf("download: create temp dir: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	var files []bundledFile
+	var errs []error
+
+	for i, item := range items {
+		tmpPath := filepath.Join(tmpDir, fmt.Sprintf("%d-%s", i, item.Filename))
+		if err := m.Download(item.Request.WithContext(ctx), tmpPath); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", item.Filename, err))
+		} else {
+			files = append(files, bundledFile{path: tmpPath, name: item.Filename})
+		}
+		if progress != nil {
+			progress(i+1, len(items))
+		}
+	}
+
+	if len(files) == 0 {
+		return fmt.Errorf("download: every format failed: %w", errors.Join(errs...))
+	}
+
+	if err := writeZip(zipPath, files); err != nil {
+		return err
+	}
+	return errors.Join(errs...)
+}
+
+func writeZip(zipPath string, files []bundledFile) error {
+	out, err := os.Create(zipPath)
+	if err != nil {
+		return fmt.Errorf("download: create %s: %w", zipPath, err)
+	}
+	defer out.Close()
+
+	zw := zip.NewWriter(out)
+	defer zw.Close()
+
+	for _, f := range files {
+		if err := addFileToZip(zw, f.path, f.name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addFileToZip(zw *zip.Writer, srcPath, name string) error {
+	in, err := os.Open(srcPath)
+	if err != nil {
+		return fmt.Errorf("download: open %s: %w", srcPath, err)
+	}
+	defer in.Close()
+
+	w, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("download: add %s to zip: %w", name, err)
+	}
+	if _, err := io.Copy(w, in); err != nil {
+		return fmt.Errorf("download: write %s to zip: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+// Package enrich fills gaps in scraped BookDetails using third-party
+// metadata APIs. The only source today is Open Library, looked up by ISBN.
+package enrich
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+const (
+	openLibraryBaseURL = "https://openlibrary.org/api/books"
+	defaultTimeout     = 5 * time.Second
+
+	// SourceOpenLibrary is the value recorded in a Result's Sources map for
+	// any field filled in from Open Library.
+	SourceOpenLibrary = "Open Library"
+)
+
+// Client queries Open Library to enrich book metadata. A disabled Client's
+// Enrich is a no-op, so callers can hold one unconditionally and let the
+// on/off switch live in configuration rather than scattered call sites.
+type Client struct {
+	httpClient *http.Client
+	enabled    bool
+}
+
+// NewClient returns an enrichment client. enabled mirrors the user's
+// "enable metadata enrichment" setting.
+func NewClient(enabled bool) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		enabled:    enabled,
+	}
+}
+
+// SetEnabled toggles enrichment on or off at runtime.
+func (c *Client) SetEnabled(enabled bool) {
+	c.enabled = enabled
+}
+
+// Result reports which BookDetails fields Enrich filled in, keyed by field
+// name, so the UI can show a "(Open Library)" marker next to them.
+type Result struct {
+	Sources map[string]string
+}
+
+type openLibraryRecord struct {
+	Details struct {
+		Title       string   `json:"title"`
+		Publishers  []string `json:"publishers"`
+		PublishDate string   `json:"publish_date"`
+		Description any      `json:"description"`
+	} `json:"details"`
+}
+
+// Enrich fills any empty fields on details using Open Library data looked
+// up by ISBN13 (falling back to ISBN10), without ever overwriting a
+// non-empty scraped value. Network failures degrade silently: details is
+// left unmodified and the returned Result has no Sources, since enrichment
+// is strictly best-effort.
+func (c *Client) Enrich(details *zlibrary.BookDetails) *Result {
+	result := &Result{Sources: make(map[string]string)}
+	if !c.enabled || details == nil {
+		return result
+	}
+
+	isbn := details.ISBN13
+	if isbn == "" {
+		isbn = details.ISBN10
+	}
+	if isbn == "" {
+		return result
+	}
+
+	record, err := c.lookup(isbn)
+	if err != nil || record == nil {
+		return result
+	}
+
+	if details.Publisher == "" && len(record.Details.Publishers) > 0 {
+		details.Publisher = record.Details.Publishers[0]
+		result.Sources["Publisher"] = SourceOpenLibrary
+	}
+	if details.Year == "" && record.Details.PublishDate != "" {
+		details.Year = record.Details.PublishDate
+		result.Sources["Year"] = SourceOpenLibrary
+	}
+	if details.Description == "" {
+		if desc := descriptionString(record.Details.Description); desc != "" {
+			details.Description = desc
+			result.Sources["Description"] = SourceOpenLibrary
+		}
+	}
+
+	return result
+}
+
+func (c *Client) lookup(isbn string) (*openLibraryRecord, error) {
+	url := fmt.Sprintf("%s?bibkeys=ISBN:%s&jscmd=details&format=json", openLibraryBaseURL, isbn)
+
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var payload map[string]openLibraryRecord
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, err
+	}
+
+	record, ok := payload["ISBN:"+isbn]
+	if !ok {
+		return nil, nil
+	}
+	return &record, nil
+}
+
+// descriptionString handles Open Library's inconsistent description shape,
+// which is sometimes a plain string and sometimes {"type": ..., "value": ...}.
+func descriptionString(raw any) string {
+	switch v := raw.(type) {
+	case string:
+		return v
+	case map[string]any:
+		if s, ok := v["value"].(string); ok {
+			return s
+		}
+	}
+	return ""
+}
@@ -0,0 +1,488 @@
+// Package gui implements the desktop UI for The Blackbook Archive using
+// giu/Dear ImGui, on top of the pluggable source.Provider backends.
+package gui
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/AllenDang/giu"
+
+	"github.com/Advik-B/The-Blackbook-Archive/catalog"
+	"github.com/Advik-B/The-Blackbook-Archive/config"
+	"github.com/Advik-B/The-Blackbook-Archive/convert"
+	"github.com/Advik-B/The-Blackbook-Archive/download"
+	"github.com/Advik-B/The-Blackbook-Archive/enrich"
+	"github.com/Advik-B/The-Blackbook-Archive/source"
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+const maxCompareSelection = 4
+
+// App holds all mutable UI state for a single run of the program.
+type App struct {
+	providers         map[string]source.Provider
+	providerNames     []string
+	activeProvider    string
+	activeProviderIdx int32
+
+	enricher   *enrich.Client
+	catalog    *catalog.Store
+	downloader *download.Manager
+	converter  *convert.Converter
+	cfg        *config.Config
+
+	query           string
+	bookURLInput    string
+	results         []zlibrary.BookSearchResult
+	suggestion      *string
+	groupEditions   bool
+	lastSearchTook  time.Duration
+
+	// lastSearchPage is the page number a.results currently reflects, so
+	// loadMoreResults knows which page to fetch next rather than always
+	// re-fetching page 2.
+	lastSearchPage int
+
+	// resultRows caches built TableRowWidgets across frames; see
+	// resultRowCache for what invalidates an entry.
+	resultRows *resultRowCache
+	status          string
+	lastStatusText  string
+	statusSetAt     time.Time
+	librarySearch   bool
+
+	savedSearches []SavedSearch
+
+	compareMode    bool
+	selected       map[string]zlibrary.BookSearchResult
+	compareDetails []*zlibrary.BookDetails
+	comparing      bool
+
+	// enrichedFields mirrors enrich.Result.Sources for the book currently
+	// shown in the details pane, so labels can be annotated per field.
+	enrichedFields map[string]string
+	activeDetails  *zlibrary.BookDetails
+
+	// activeDetailsIsCached marks that activeDetails came from the local
+	// catalog rather than a live fetch, because the network request failed
+	// and a cached copy was available to fall back to. The details pane
+	// shows a banner while this is set.
+	activeDetailsIsCached bool
+
+	// activeDownloads counts downloads currently in flight. Accessed
+	// atomically since downloads run on their own goroutine.
+	activeDownloads  int32
+	closeWarningAck  bool
+	lastDownloadPath string
+
+	// thumbnails caches decoded cover textures for the results list.
+	thumbnails *thumbnailCache
+
+	// coverViewer holds the state of the full-size cover popup opened from
+	// the details pane - nil when it's closed.
+	coverViewer *coverViewerState
+
+	// savedBookIDs tracks which books this session has saved to the
+	// Z-Library account, keyed by BookSearchResult.ID, so the star toggle
+	// in the details pane reflects saved state without a round trip.
+	savedBookIDs map[string]bool
+
+	// formatSizes caches HEAD-probed sizes for OtherFormats entries whose
+	// scraped markup didn't carry one, keyed by format URL.
+	formatSizesMu sync.Mutex
+	formatSizes   map[string]int64
+
+	// activeIPFSGatewayIdx indexes into the active book's
+	// Client.IPFSGatewayURLs result, for the gateway picker in the
+	// details pane.
+	activeIPFSGatewayIdx int32
+
+	// ipfsGatewaysInput is the comma-separated editable form of
+	// cfg.IPFSGateways shown in the settings panel.
+	ipfsGatewaysInput string
+
+	// preferredFormatsInput is the comma-separated editable form of
+	// cfg.PreferredFormats shown in the settings panel.
+	preferredFormatsInput string
+
+	// mirrorCandidatesInput is the comma-separated editable form of
+	// cfg.MirrorCandidates shown in the settings panel.
+	mirrorCandidatesInput string
+
+	// pendingConversionFormat holds the format awaiting a second click to
+	// confirm requestFormatConversion, since conversions count against a
+	// limited daily quota.
+	pendingConversionFormat string
+
+	// comments, commentsPage, and showComments back the collapsible
+	// comments section in the details pane.
+	comments     []zlibrary.Comment
+	commentsPage int
+	showComments bool
+
+	showSettings bool
+
+	// popularTerms caches the discovery sidebar's chips for the session,
+	// so clearing the results list doesn't refetch them on every frame.
+	// popularTermsLoaded is set on the first attempt, successful or not,
+	// so a markup change that makes scraping fail just leaves the sidebar
+	// empty instead of retrying forever.
+	popularTerms       []zlibrary.Term
+	popularTermsLoaded bool
+
+	// recentViews and showRecent back the collapsible "Recent" section,
+	// backed by the catalog's persisted recently-viewed list.
+	recentViews []zlibrary.BookSearchResult
+	showRecent  bool
+
+	// isbnImportPath, isbnImportRows, and isbnImportSelected back the
+	// batch ISBN import review table. isbnImportRunning/isbnImportCancel
+	// track the cancellable background lookup loop.
+	isbnImportPath     string
+	isbnImportRows     []isbnImportRow
+	isbnImportSelected map[int]bool
+	isbnImportRunning  int32
+	isbnImportCancel   context.CancelFunc
+
+	// connectivityChecked, connectivityOK, and connectivityFinalURL back
+	// the startup reachability indicator. mirrorInput is the editable form
+	// of cfg.ZLibraryBaseURL shown in the settings panel.
+	connectivityChecked  bool
+	connectivityOK       bool
+	connectivityFinalURL string
+	mirrorInput          string
+
+	// hiddenResults tracks results hidden from the current results view via
+	// the row context menu, keyed by DetailsURL. It's never consulted by
+	// runSearch or anything else that populates a.results - hiding only
+	// affects what's currently rendered, not the underlying result set.
+	hiddenResults map[string]bool
+
+	// unavailableResult holds the result openDetails last failed to load
+	// because its details page is gone (zlibrary.ErrBookRemoved), so the
+	// UI can offer to drop it from the recent/saved lists instead of just
+	// showing a dead-end error.
+	unavailableResult *zlibrary.BookSearchResult
+
+	// pendingBulkDownloadConfirm gates a bulk download action behind one
+	// extra click once cfg.DailyDownloadSoftLimit has been reached, the
+	// same "click again to confirm" pattern requestFormatConversion uses.
+	pendingBulkDownloadConfirm bool
+
+	// pendingOverwriteConfirm holds the destination path awaiting a second
+	// click to confirm overwriting an existing file - see
+	// confirmOverwrite - unless cfg.SkipOverwriteConfirm is set, in which
+	// case a download never has to pause for this at all.
+	pendingOverwriteConfirm string
+
+	// userFetchActive counts user-initiated detail fetches and downloads
+	// currently in flight, so the background enrichment worker (see
+	// enrichment.go) can pause rather than compete with them for the same
+	// rate-limited connection.
+	userFetchActive int32
+
+	// enrichmentCancel stops the background enrichment worker started for
+	// the current result set, if any. A fresh search or an explicit
+	// disable cancels it before a new one (if any) is started.
+	enrichmentCancel context.CancelFunc
+
+	// autoDiscoverMirrorsTried keeps checkConnectivity from triggering more
+	// than one automatic discoverMirrors run per session, even if the
+	// newly-applied mirror also turns out to be unreachable.
+	autoDiscoverMirrorsTried bool
+
+	// stats collects request volume/latency/error metrics for the
+	// Z-Library client via SetRequestHook/SetResponseHook, and backs the
+	// "requests in flight" badge next to the connectivity indicator.
+	stats *zlibrary.StatsCollector
+
+	// journal records recent Z-Library request/response exchanges for the
+	// requests panel opened from the connectivity indicator; see
+	// requests_journal.go. showJournal tracks whether that panel is open.
+	journal     *zlibrary.RequestJournal
+	showJournal bool
+
+	// downloadAsFormat and downloadAsPath back the inline "Download As..."
+	// path editor in the details pane; see download_as.go.
+	// downloadAsFormat is empty when the editor is closed.
+	downloadAsFormat string
+	downloadAsPath   string
+
+	// prefetchCancel stops the cover prefetch pool started for the current
+	// result set, if any; see prefetch.go. A fresh search cancels it before
+	// a new one is started.
+	prefetchCancel context.CancelFunc
+
+	// focusSearchBox is set for one frame by the Ctrl+L/Ctrl+F shortcut in
+	// Loop, and consumed by searchBarWidget to focus the search field.
+	focusSearchBox bool
+}
+
+// NewApp wires up a fresh App, with every known Provider registered and the
+// configured default selected.
+func NewApp(cfg *config.Config) *App {
+	zlibSource := source.NewZLibrarySource(zlibrary.NewClient())
+	libgenSource := source.NewLibGenSource("")
+	source.RegisterSource(zlibSource.Name(), zlibSource)
+	source.RegisterSource(libgenSource.Name(), libgenSource)
+
+	providers := source.Sources()
+	names := source.SourceNames()
+
+	active := cfg.DefaultSource
+	activeIdx := int32(0)
+	for i, name := range names {
+		if name == active {
+			activeIdx = int32(i)
+		}
+	}
+	if _, ok := providers[active]; !ok {
+		active = names[0]
+		activeIdx = 0
+	}
+
+	downloader := download.NewManager(cfg.MaxDownloadSizeBytes)
+	downloader.SetMaxDownloadBytesPerSec(cfg.MaxDownloadBytesPerSec)
+
+	thumbnails := newThumbnailCache()
+	thumbnails.SetMaxConcurrentFetches(cfg.MaxConcurrentImageFetches)
+
+	app := &App{
+		providers:             providers,
+		providerNames:         names,
+		activeProvider:        active,
+		activeProviderIdx:     activeIdx,
+		enricher:              enrich.NewClient(cfg.EnableEnrichment),
+		downloader:            downloader,
+		converter:             convert.NewConverter(cfg.ConversionToolPath),
+		cfg:                   cfg,
+		selected:              make(map[string]zlibrary.BookSearchResult),
+		status:                "Ready.",
+		thumbnails:            thumbnails,
+		resultRows:            newResultRowCache(),
+		savedBookIDs:          make(map[string]bool),
+		formatSizes:           make(map[string]int64),
+		ipfsGatewaysInput:     strings.Join(cfg.IPFSGateways, ", "),
+		preferredFormatsInput: strings.Join(cfg.PreferredFormats, ", "),
+		mirrorInput:           cfg.ZLibraryBaseURL,
+		mirrorCandidatesInput: strings.Join(cfg.MirrorCandidates, ", "),
+	}
+
+	if path, err := config.CatalogPath(); err == nil {
+		os.MkdirAll(filepath.Dir(path), 0o755)
+		if store, err := catalog.Open(path); err == nil {
+			app.catalog = store
+		}
+	}
+
+	app.applyDebugSetting()
+	app.applyHTTPDumpSetting()
+	app.applyUserAgentSettings()
+	app.applySafeModeSetting()
+	app.applyIPFSGatewaySettings()
+	app.applyPreferredFormatsSetting()
+	app.applyMirrorRacingSettings()
+	app.applyLanguageSetting()
+	if cfg.ZLibraryBaseURL != "" {
+		zlibSource.Client.SetBaseURL(cfg.ZLibraryBaseURL)
+	}
+	go app.checkConnectivity()
+
+	zlibSource.Client.SetRateLimitCallback(func(wait time.Duration) {
+		app.status = fmt.Sprintf("Rate limited - waiting %s before retrying.", wait.Round(time.Second))
+		giu.Update()
+	})
+
+	zlibSource.Client.SetSearchParseProgressCallback(func(parsed, total int) {
+		if total > 1 {
+			app.status = fmt.Sprintf("Parsing search results... %d / %d", parsed, total)
+			giu.Update()
+		}
+	})
+
+	app.stats = zlibrary.NewStatsCollector()
+	app.journal = zlibrary.NewRequestJournal()
+	statsReqHook, journalReqHook := app.stats.RequestHook(), app.journal.RequestHook()
+	zlibSource.Client.SetRequestHook(func(req *http.Request) {
+		statsReqHook(req)
+		journalReqHook(req)
+	})
+	statsRespHook, journalRespHook := app.stats.ResponseHook(), app.journal.ResponseHook()
+	zlibSource.Client.SetResponseHook(func(req *http.Request, resp *http.Response, d time.Duration, err error) {
+		statsRespHook(req, resp, d, err)
+		journalRespHook(req, resp, d, err)
+	})
+
+	app.startSavedSearchPolling()
+
+	return app
+}
+
+// Loop is the giu render callback, invoked on every frame.
+func (a *App) Loop() {
+	a.expireTransientStatus()
+
+	ctrl := giu.IsKeyDown(giu.KeyLeftControl) || giu.IsKeyDown(giu.KeyRightControl)
+	if ctrl && (giu.IsKeyPressed(giu.KeyL) || giu.IsKeyPressed(giu.KeyF)) {
+		a.focusSearchBox = true
+	}
+
+	giu.SingleWindow().Layout(
+		a.connectivityIndicatorWidget(),
+		a.inFlightBadgeWidget(),
+		a.requestJournalToggleWidget(),
+		a.requestJournalWidget(),
+		giu.Separator(),
+		a.searchBarWidget(),
+		a.openURLWidget(),
+		giu.Separator(),
+		a.resultsListWidget(),
+		giu.Separator(),
+		a.savedSearchesWidget(),
+		giu.Separator(),
+		a.recentViewsWidget(),
+		a.unavailableResultWidget(),
+		giu.Separator(),
+		a.isbnImportWidget(),
+		giu.Separator(),
+		a.comparisonPanelWidget(),
+		giu.Separator(),
+		a.detailsPaneWidget(),
+		giu.Separator(),
+		a.settingsWidget(),
+		giu.Separator(),
+		a.statusBarWidget(),
+	)
+}
+
+// statusBarWidget renders the status bar as two independent regions: a
+// left transient-message area (search timing, toasts, rate-limit notices -
+// see expireTransientStatus) and a right persistent area owned by the
+// download manager, so a long download's feedback can't be stomped by
+// browsing other books while it runs.
+func (a *App) statusBarWidget() giu.Widget {
+	left := []giu.Widget{giu.Label(a.status)}
+	if len(a.results) > 0 {
+		left = append(left, giu.Label(fmt.Sprintf("%d result(s) in %s", len(a.results), a.lastSearchTook.Round(time.Millisecond))))
+	}
+
+	if downloadStatus := a.downloadStatusText(); downloadStatus != "" {
+		left = append(left, giu.Label(downloadStatus))
+	}
+	left = append(left, giu.Label(a.downloadsTodayText()))
+
+	return giu.Row(left...)
+}
+
+// ConfirmClose is wired up as the master window's close callback. It warns
+// the user instead of closing outright the first time there are active
+// downloads, then allows a second close attempt through regardless.
+func (a *App) ConfirmClose() bool {
+	if atomic.LoadInt32(&a.activeDownloads) == 0 || a.closeWarningAck {
+		return true
+	}
+	a.closeWarningAck = true
+	a.status = "Downloads are still in progress - close again to quit anyway."
+	return false
+}
+
+// providerFor looks up the Provider that produced a given result, by its
+// Source field, so follow-up Details/DownloadRequest calls route back to
+// the backend that actually knows about the book.
+func (a *App) providerFor(sourceName string) (source.Provider, bool) {
+	p, ok := a.providers[sourceName]
+	return p, ok
+}
+
+func (a *App) runSearch() {
+	if a.librarySearch {
+		a.runLibrarySearch()
+		return
+	}
+
+	provider, ok := a.providers[a.activeProvider]
+	if !ok {
+		a.status = "No source selected."
+		return
+	}
+
+	a.suggestion = nil
+	start := time.Now()
+
+	var results []zlibrary.BookSearchResult
+	var redirect *zlibrary.SearchRedirect
+	var err error
+	if client := a.zlibClient(); client != nil && a.activeProvider == zlibrary.SourceName {
+		results, a.suggestion, redirect, err = client.SearchZLibraryWithSuggestion(context.Background(), a.query, 1)
+	} else {
+		results, err = provider.Search(context.Background(), a.query, 1)
+	}
+
+	a.lastSearchTook = time.Since(start)
+	if err != nil {
+		a.status = "Search failed: " + err.Error()
+		return
+	}
+	if redirect != nil {
+		a.openDetails(zlibrary.BookSearchResult{
+			Source:     zlibrary.SourceName,
+			Title:      redirect.Title,
+			DetailsURL: redirect.DetailsURL,
+		})
+		// As with openDetailsFromURL, replace the results list with a single
+		// synthetic entry for the opened book, so the context menu, compare
+		// mode, and "hide from results" all have something to act on.
+		if a.activeDetails != nil && a.activeDetails.DetailsURL == redirect.DetailsURL {
+			a.results = []zlibrary.BookSearchResult{a.activeDetails.BookSearchResult}
+			a.lastSearchPage = 1
+			a.selected = make(map[string]zlibrary.BookSearchResult)
+			a.invalidateResultRowCache()
+		}
+		return
+	}
+	a.results = results
+	a.lastSearchPage = 1
+	a.invalidateResultRowCache()
+	a.selected = make(map[string]zlibrary.BookSearchResult)
+	a.status = "Ready."
+
+	if a.catalog != nil {
+		for _, r := range results {
+			a.catalog.SaveSearchResult(r)
+		}
+	}
+
+	a.stopEnrichmentWorker()
+	a.startEnrichmentWorker()
+	a.startCoverPrefetch(results)
+}
+
+// runLibrarySearch searches the local catalog instead of hitting the
+// network, for the "my library" toggle.
+func (a *App) runLibrarySearch() {
+	if a.catalog == nil {
+		a.status = "Local catalog is unavailable."
+		return
+	}
+
+	start := time.Now()
+	results, err := a.catalog.Search(a.query)
+	a.lastSearchTook = time.Since(start)
+	if err != nil {
+		a.status = "Library search failed: " + err.Error()
+		return
+	}
+	a.results = results
+	a.lastSearchPage = 1
+	a.invalidateResultRowCache()
+	a.selected = make(map[string]zlibrary.BookSearchResult)
+	a.status = "Ready."
+}
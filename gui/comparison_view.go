@@ -0,0 +1,105 @@
+package gui
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/AllenDang/giu"
+
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+// comparisonPanelWidget renders the side-by-side comparison table for
+// whatever books are currently selected in compare mode.
+func (a *App) comparisonPanelWidget() giu.Widget {
+	if !a.compareMode || len(a.selected) == 0 {
+		return giu.Row()
+	}
+
+	rows := []*giu.TableRowWidget{
+		giu.TableRow(
+			giu.Label("Title"),
+			giu.Label("Format"),
+			giu.Label("Size"),
+			giu.Label("Year"),
+			giu.Label("Language"),
+			giu.Label("Rating"),
+			giu.Label("Pages"),
+			giu.Label("Edition"),
+			giu.Label("Series"),
+		),
+	}
+	for _, d := range a.compareDetails {
+		if d == nil {
+			continue
+		}
+		rows = append(rows, giu.TableRow(
+			giu.Label(d.Title),
+			giu.Label(d.Format),
+			giu.Label(d.SizeText),
+			giu.Label(d.Year),
+			giu.Label(d.Language),
+			giu.Label(d.Rating),
+			giu.Label(pagesText(d.Pages)),
+			giu.Label(d.Edition),
+			giu.Label(seriesText(d.Series, d.SeriesIndex)),
+		))
+	}
+
+	return giu.Column(
+		giu.Button("Compare selected").OnClick(a.fetchComparisonDetails),
+		giu.Table().Rows(rows...),
+	)
+}
+
+// fetchComparisonDetails resolves full details for every currently selected
+// book concurrently, routing each fetch through the provider it actually
+// came from, so the comparison table has more than the summary fields
+// already on hand from the search results.
+func (a *App) fetchComparisonDetails() {
+	selected := make([]zlibrary.BookSearchResult, 0, len(a.selected))
+	for _, r := range a.selected {
+		selected = append(selected, r)
+	}
+
+	a.comparing = true
+	defer func() { a.comparing = false }()
+
+	details := make([]*zlibrary.BookDetails, len(selected))
+	var failures int
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for i, r := range selected {
+		wg.Add(1)
+		go func(i int, r zlibrary.BookSearchResult) {
+			defer wg.Done()
+
+			provider, ok := a.providerFor(r.Source)
+			if !ok {
+				mu.Lock()
+				failures++
+				mu.Unlock()
+				return
+			}
+
+			d, err := provider.Details(context.Background(), r.DetailsURL)
+			if err != nil {
+				mu.Lock()
+				failures++
+				mu.Unlock()
+				return
+			}
+			details[i] = d
+		}(i, r)
+	}
+	wg.Wait()
+
+	a.compareDetails = details
+	if failures > 0 {
+		a.status = fmt.Sprintf("Fetched comparison details with %d failure(s).", failures)
+		return
+	}
+	a.status = "Ready."
+}
@@ -0,0 +1,132 @@
+package gui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AllenDang/giu"
+)
+
+// checkConnectivity runs Client.CheckConnectivity in the background and
+// updates the connectivity indicator once it returns. Called once at
+// startup, and again whenever the user switches mirrors. If the check
+// fails and the user has consented via cfg.AutoDiscoverMirrors, it follows
+// up with a mirror discovery run instead of just reporting unreachable.
+func (a *App) checkConnectivity() {
+	client := a.zlibClient()
+	if client == nil {
+		return
+	}
+
+	ok, finalURL, err := client.CheckConnectivity(context.Background())
+	a.connectivityChecked = true
+	a.connectivityOK = ok && err == nil
+	a.connectivityFinalURL = finalURL
+	if !a.connectivityOK {
+		a.status = "Can't reach Z-Library right now - try a different mirror in Settings."
+		if a.cfg.AutoDiscoverMirrors && !a.autoDiscoverMirrorsTried {
+			a.autoDiscoverMirrorsTried = true
+			go a.discoverMirrors()
+		}
+	}
+	giu.Update()
+}
+
+// discoverMirrorsCacheTTL is how long a previous DiscoverMirrors run's
+// result is trusted before discoverMirrors probes again instead of reusing
+// cfg.DiscoveredMirrors.
+const discoverMirrorsCacheTTL = 24 * time.Hour
+
+// discoverMirrors runs Client.DiscoverMirrors, switches the client to the
+// fastest live mirror found, and persists the full ranked list to config so
+// a later call - automatic or button-triggered - can skip probing while the
+// result is still fresh.
+func (a *App) discoverMirrors() {
+	if len(a.cfg.DiscoveredMirrors) > 0 && time.Since(a.cfg.DiscoveredMirrorsAt) < discoverMirrorsCacheTTL {
+		a.useDiscoveredMirror(a.cfg.DiscoveredMirrors[0])
+		return
+	}
+
+	client := a.zlibClient()
+	if client == nil {
+		return
+	}
+
+	a.status = "Looking for a working mirror..."
+	giu.Update()
+
+	found, err := client.DiscoverMirrors(context.Background())
+	if err != nil {
+		a.status = "Couldn't find a working mirror: " + err.Error()
+		giu.Update()
+		return
+	}
+
+	mirrors := make([]string, len(found))
+	for i, m := range found {
+		mirrors[i] = m.BaseURL
+	}
+	a.cfg.DiscoveredMirrors = mirrors
+	a.cfg.DiscoveredMirrorsAt = time.Now()
+	a.cfg.Save()
+
+	a.useDiscoveredMirror(mirrors[0])
+}
+
+// useDiscoveredMirror switches the Z-Library client (and cfg.ZLibraryBaseURL)
+// to baseURL and re-checks connectivity against it.
+func (a *App) useDiscoveredMirror(baseURL string) {
+	client := a.zlibClient()
+	if client == nil {
+		return
+	}
+	a.mirrorInput = baseURL
+	a.cfg.ZLibraryBaseURL = baseURL
+	client.SetBaseURL(baseURL)
+	a.status = "Switched to discovered mirror: " + baseURL
+	giu.Update()
+	a.checkConnectivity()
+}
+
+// connectivityIndicatorWidget shows whether the active source is
+// currently reachable, once the startup (or most recent) check has
+// completed.
+func (a *App) connectivityIndicatorWidget() giu.Widget {
+	if !a.connectivityChecked {
+		return giu.Row()
+	}
+	if a.connectivityOK {
+		return giu.Row(giu.Label("Z-Library: reachable"))
+	}
+	return giu.Row(giu.Label("Z-Library: unreachable or blocked - try a mirror in Settings."))
+}
+
+// inFlightBadgeWidget shows a small "N requests in flight" indicator while
+// the Z-Library client has outstanding requests, and nothing otherwise.
+func (a *App) inFlightBadgeWidget() giu.Widget {
+	if a.stats == nil {
+		return giu.Row()
+	}
+	n := a.stats.ClientStats().InFlight
+	if n <= 0 {
+		return giu.Row()
+	}
+	return giu.Row(giu.Label(fmt.Sprintf("%d request(s) in flight...", n)))
+}
+
+// applyMirrorSetting points the Z-Library client at a.mirrorInput (blank
+// for the built-in default) and re-checks connectivity against it.
+func (a *App) applyMirrorSetting() {
+	a.cfg.ZLibraryBaseURL = strings.TrimSpace(a.mirrorInput)
+
+	client := a.zlibClient()
+	if client == nil {
+		return
+	}
+	if a.cfg.ZLibraryBaseURL != "" {
+		client.SetBaseURL(a.cfg.ZLibraryBaseURL)
+	}
+	go a.checkConnectivity()
+}
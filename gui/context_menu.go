@@ -0,0 +1,160 @@
+package gui
+
+import (
+	"context"
+	"os/exec"
+	"runtime"
+	"sync/atomic"
+
+	"github.com/AllenDang/giu"
+	"github.com/atotto/clipboard"
+
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+// visibleResults is a.results with anything hidden this session (see
+// hideResult) filtered out. It never mutates a.results itself, so clearing
+// the hidden set or re-running source.Provider queries can't be affected by
+// what's currently hidden from view.
+func (a *App) visibleResults() []zlibrary.BookSearchResult {
+	if len(a.hiddenResults) == 0 {
+		return a.results
+	}
+
+	visible := make([]zlibrary.BookSearchResult, 0, len(a.results))
+	for _, r := range a.results {
+		if !a.hiddenResults[r.DetailsURL] {
+			visible = append(visible, r)
+		}
+	}
+	return visible
+}
+
+// hideResult removes a result from the current view without touching
+// a.results, so a fresh search or a "My library" toggle starts clean again.
+func (a *App) hideResult(r zlibrary.BookSearchResult) {
+	if a.hiddenResults == nil {
+		a.hiddenResults = make(map[string]bool)
+	}
+	a.hiddenResults[r.DetailsURL] = true
+	a.invalidateResultRowCache()
+}
+
+// resultContextMenuWidget attaches a right-click context menu to the
+// previously submitted widget (the result's title button), offering the
+// handful of actions that would otherwise require opening the details pane
+// first.
+func (a *App) resultContextMenuWidget(r zlibrary.BookSearchResult) giu.Widget {
+	return giu.ContextMenu().Layout(
+		giu.Selectable("Open details").OnClick(func() { a.openDetails(r) }),
+		giu.Selectable("Download directly").OnClick(func() { a.downloadResultDirectly(r) }),
+		giu.Selectable("Copy title").OnClick(func() { clipboard.WriteAll(r.Title) }),
+		giu.Selectable("Copy book URL").OnClick(func() { clipboard.WriteAll(r.DetailsURL) }),
+		giu.Selectable("Copy share link").OnClick(func() { a.copyShareLink(r) }),
+		giu.Selectable("Open in browser").OnClick(func() { a.openResultInBrowser(r) }),
+		giu.Selectable("Hide from results").OnClick(func() { a.hideResult(r) }),
+	)
+}
+
+// downloadResultDirectly fetches r's details and downloads its best
+// available format, reporting progress through the status bar the same way
+// downloadFormat does - without requiring the user to open the details pane
+// first.
+func (a *App) downloadResultDirectly(r zlibrary.BookSearchResult) {
+	provider, ok := a.providerFor(r.Source)
+	if !ok {
+		a.status = "Unknown source: " + r.Source
+		return
+	}
+
+	go func() {
+		details, err := provider.Details(context.Background(), r.DetailsURL)
+		if err != nil {
+			a.status = "Failed to load details: " + err.Error()
+			giu.Update()
+			return
+		}
+		if !canDownload(details) {
+			a.status = "This book only offers formats that require local conversion."
+			giu.Update()
+			return
+		}
+
+		format := a.primaryDownloadFormat(details)
+		req, err := provider.DownloadRequest(context.Background(), details, format)
+		if err != nil {
+			a.status = "Download failed: " + err.Error()
+			giu.Update()
+			return
+		}
+
+		destPath := a.downloadDestPath(a.cfg.DownloadDir, details.Title, details.Author, details.ID, format)
+		if !a.confirmOverwrite(destPath) {
+			giu.Update()
+			return
+		}
+
+		atomic.AddInt32(&a.activeDownloads, 1)
+		defer atomic.AddInt32(&a.activeDownloads, -1)
+
+		if err := a.downloader.Download(req, destPath); err != nil {
+			a.status = "Download failed: " + err.Error()
+			giu.Update()
+			return
+		}
+		a.finishDownload(destPath, format, details.Title, details.Author, "Downloaded to "+destPath)
+		giu.Update()
+	}()
+}
+
+// openResultInBrowser opens a result's details page in the system's default
+// browser, for a user who'd rather read the listing on the site itself.
+func (a *App) openResultInBrowser(r zlibrary.BookSearchResult) {
+	if r.DetailsURL == "" {
+		return
+	}
+	if err := openInBrowser(r.DetailsURL); err != nil {
+		a.status = "Failed to open browser: " + err.Error()
+	}
+}
+
+// copyShareLink copies a mirror-independent "zlib://book/<id>" link for r,
+// plus its current full URL as a fallback line for anyone whose client
+// doesn't understand the scheme. Only Z-Library results carry a
+// canonicalizable ID today.
+func (a *App) copyShareLink(r zlibrary.BookSearchResult) {
+	client := a.zlibClient()
+	if client == nil || r.Source != zlibrary.SourceName {
+		a.status = "Share links are only supported for Z-Library."
+		return
+	}
+
+	shareLink, fullURL, ok := client.ShareLink(r.DetailsURL)
+	if !ok {
+		a.status = "Couldn't build a share link for this book."
+		return
+	}
+
+	clipboard.WriteAll(shareLink + "\n" + fullURL)
+	a.status = "Share link copied to clipboard."
+}
+
+// openInBrowser shells out to the platform's default opener for a URL.
+func openInBrowser(url string) error {
+	return openWithSystemDefault(url)
+}
+
+// openWithSystemDefault shells out to the platform's default opener for a
+// URL or local file path alike. There's no browser/file-opener dependency
+// in go.mod, and the handful of commands this needs don't warrant adding
+// one.
+func openWithSystemDefault(target string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("open", target).Start()
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", target).Start()
+	default:
+		return exec.Command("xdg-open", target).Start()
+	}
+}
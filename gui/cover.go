@@ -0,0 +1,274 @@
+package gui
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/AllenDang/giu"
+	"golang.org/x/image/draw"
+
+	"github.com/Advik-B/The-Blackbook-Archive/assets"
+	"github.com/Advik-B/The-Blackbook-Archive/images"
+)
+
+const (
+	defaultMaxCoverImageBytes   = 10 << 20
+	defaultImageFetchTimeout    = 10 * time.Second
+	defaultMaxConcurrentFetches = 4
+)
+
+// errTextureCreationFailed distinguishes a GPU/GL-side texture creation
+// failure (e.g. a context issue) from a network or decode failure, so the
+// cache can report and retry each kind sensibly.
+var errTextureCreationFailed = errors.New("cover: texture creation failed")
+
+// thumbnailCache holds decoded cover textures keyed by cover URL, along
+// with which URLs are currently loading or have failed, so the results
+// list doesn't re-fetch or re-decode the same image every frame.
+// fetchFailed and textureFailed are tracked separately since a texture
+// creation failure (a local GL issue) is worth retrying sooner than a
+// network failure would be, and a caller may want to tell them apart.
+type thumbnailCache struct {
+	mu            sync.Mutex
+	textures      map[string]*giu.Texture
+	loading       map[string]bool
+	fetchFailed   map[string]bool
+	textureFailed map[string]bool
+
+	// placeholder is the embedded placeholder cover, decoded into a
+	// texture lazily on first use and reused for every missing, loading,
+	// or failed cover rather than being redecoded per URL.
+	placeholderOnce sync.Once
+	placeholder     *giu.Texture
+
+	// sem bounds how many cover fetches - thumbnails and the details-pane
+	// cover alike, since both go through fetchThumbnail - run at once.
+	// Fetches past the limit block on acquiring it rather than being
+	// dropped, so a huge result set queues its fetches instead of opening
+	// dozens of connections simultaneously.
+	sem chan struct{}
+}
+
+func newThumbnailCache() *thumbnailCache {
+	c := &thumbnailCache{
+		textures:      make(map[string]*giu.Texture),
+		loading:       make(map[string]bool),
+		fetchFailed:   make(map[string]bool),
+		textureFailed: make(map[string]bool),
+	}
+	c.SetMaxConcurrentFetches(defaultMaxConcurrentFetches)
+	return c
+}
+
+// SetMaxConcurrentFetches resizes the fetch semaphore. n <= 0 falls back to
+// defaultMaxConcurrentFetches. Fetches already in flight against the old
+// semaphore are unaffected; only fetches started afterward observe the new
+// limit.
+func (c *thumbnailCache) SetMaxConcurrentFetches(n int) {
+	if n <= 0 {
+		n = defaultMaxConcurrentFetches
+	}
+	c.mu.Lock()
+	c.sem = make(chan struct{}, n)
+	c.mu.Unlock()
+}
+
+// placeholderTexture decodes the embedded placeholder cover into a texture
+// the first time it's needed, sized the same as a real thumbnail so
+// swapping one in for the other (once a real cover loads) doesn't jump the
+// layout.
+func (a *App) placeholderTexture() *giu.Texture {
+	a.thumbnails.placeholderOnce.Do(func() {
+		texture, err := decodeToTexture(bytes.NewReader(assets.PlaceholderCoverPNG), a.thumbnailSize())
+		if err == nil {
+			a.thumbnails.placeholder = texture
+		}
+	})
+	return a.thumbnails.placeholder
+}
+
+func (a *App) thumbnailSize() int {
+	if a.cfg.ThumbnailSize > 0 {
+		return a.cfg.ThumbnailSize
+	}
+	return 96
+}
+
+// thumbnailWidget returns a widget showing the cover at coverURL once
+// loaded, kicking off a background fetch the first time it's seen. Until
+// the real cover arrives - or if it never does, on a missing URL or a
+// failed fetch/texture creation - the placeholder cover renders in its
+// place, so the layout never goes ragged. A failed cover renders the
+// placeholder as a clickable retry button rather than switching to a
+// different layout.
+func (a *App) thumbnailWidget(coverURL string) giu.Widget {
+	placeholder := a.placeholderTexture()
+	if coverURL == "" {
+		if placeholder == nil {
+			return giu.Label("")
+		}
+		return giu.Image(placeholder)
+	}
+
+	a.thumbnails.mu.Lock()
+	texture := a.thumbnails.textures[coverURL]
+	fetchFailed := a.thumbnails.fetchFailed[coverURL]
+	textureFailed := a.thumbnails.textureFailed[coverURL]
+	alreadyLoading := a.thumbnails.loading[coverURL]
+	if texture == nil && !fetchFailed && !textureFailed && !alreadyLoading {
+		a.thumbnails.loading[coverURL] = true
+		go a.fetchThumbnail(coverURL)
+	}
+	a.thumbnails.mu.Unlock()
+
+	if texture != nil {
+		return giu.Image(texture)
+	}
+	if placeholder == nil {
+		if fetchFailed || textureFailed {
+			return giu.Button("↻").OnClick(func() { a.retryThumbnail(coverURL) })
+		}
+		return giu.Label("")
+	}
+	if fetchFailed || textureFailed {
+		return giu.ImageButton(placeholder).OnClick(func() { a.retryThumbnail(coverURL) })
+	}
+	return giu.Image(placeholder)
+}
+
+func (a *App) fetchThumbnail(coverURL string) {
+	texture, err := a.loadThumbnail(coverURL)
+
+	a.thumbnails.mu.Lock()
+	delete(a.thumbnails.loading, coverURL)
+	switch {
+	case errors.Is(err, errTextureCreationFailed):
+		a.thumbnails.textureFailed[coverURL] = true
+	case err != nil:
+		a.thumbnails.fetchFailed[coverURL] = true
+	default:
+		a.thumbnails.textures[coverURL] = texture
+	}
+	a.thumbnails.mu.Unlock()
+
+	giu.Update()
+}
+
+// retryThumbnail clears any cached failure for coverURL, so the next frame
+// kicks off a fresh fetch - the manual retry affordance behind the retry
+// button thumbnailWidget shows for a failed cover.
+func (a *App) retryThumbnail(coverURL string) {
+	a.thumbnails.mu.Lock()
+	delete(a.thumbnails.fetchFailed, coverURL)
+	delete(a.thumbnails.textureFailed, coverURL)
+	a.thumbnails.mu.Unlock()
+	giu.Update()
+}
+
+// loadThumbnail fetches a cover image and decodes it into a texture sized
+// to the configured thumbnail size, so the results list can render covers
+// without holding full-resolution images in memory. The fetch goes through
+// images.Fetch, which enforces a max-bytes read limit and rejects a
+// non-image Content-Type (e.g. an HTML error page returned in place of a
+// cover), bounded by a dedicated timeout so a broken or malicious cover
+// URL can't hang the fetch.
+func (a *App) loadThumbnail(coverURL string) (*giu.Texture, error) {
+	maxBytes := a.cfg.MaxCoverImageBytes
+	if maxBytes <= 0 {
+		maxBytes = defaultMaxCoverImageBytes
+	}
+	timeout := defaultImageFetchTimeout
+	if a.cfg.ImageFetchTimeoutSeconds > 0 {
+		timeout = time.Duration(a.cfg.ImageFetchTimeoutSeconds) * time.Second
+	}
+
+	a.thumbnails.mu.Lock()
+	sem := a.thumbnails.sem
+	a.thumbnails.mu.Unlock()
+	sem <- struct{}{}
+	defer func() { <-sem }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	img, err := images.Fetch(ctx, nil, coverURL, maxBytes)
+	if err != nil {
+		return nil, fmt.Errorf("cover: fetch: %w", err)
+	}
+	return textureFromImage(img, a.thumbnailSize())
+}
+
+// decodeToTexture decodes an image from r and scales it into a texture of
+// size x size, used by placeholderTexture to decode the embedded
+// placeholder. A fetched cover goes through loadThumbnail/images.Fetch
+// instead, which additionally validates Content-Type and size.
+func decodeToTexture(r io.Reader, size int) (*giu.Texture, error) {
+	img, _, err := image.Decode(r)
+	if err != nil {
+		return nil, fmt.Errorf("cover: decode: %w", err)
+	}
+	return textureFromImage(img, size)
+}
+
+// textureFromImage scales img into a texture of size x size and uploads
+// it, the shared tail end of both decodeToTexture and loadThumbnail. It
+// distorts a non-square source to fill the square - an acceptable
+// simplification for a small results-list thumbnail, but not for a
+// full-size view; see textureFromImageFit for that case.
+func textureFromImage(img image.Image, size int) (*giu.Texture, error) {
+	thumb := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.CatmullRom.Scale(thumb, thumb.Bounds(), img, img.Bounds(), draw.Over, nil)
+	return uploadTexture(thumb)
+}
+
+// textureFromImageFit scales img to fit within a maxSize x maxSize box
+// without distorting its aspect ratio, for the full-size cover viewer
+// where stretching a non-square cover to a square would look wrong.
+func textureFromImageFit(img image.Image, maxSize int) (*giu.Texture, error) {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	if w <= 0 || h <= 0 {
+		return nil, errTextureCreationFailed
+	}
+
+	scale := float64(maxSize) / float64(max(w, h))
+	if scale > 1 {
+		scale = 1
+	}
+	fitW, fitH := int(float64(w)*scale), int(float64(h)*scale)
+	if fitW < 1 {
+		fitW = 1
+	}
+	if fitH < 1 {
+		fitH = 1
+	}
+
+	fit := image.NewRGBA(image.Rect(0, 0, fitW, fitH))
+	draw.CatmullRom.Scale(fit, fit.Bounds(), img, bounds, draw.Over, nil)
+	return uploadTexture(fit)
+}
+
+// uploadTexture uploads an already-sized RGBA image to the GPU, the
+// shared tail end of textureFromImage and textureFromImageFit.
+func uploadTexture(rgba *image.RGBA) (*giu.Texture, error) {
+	var texture *giu.Texture
+	done := make(chan struct{})
+	giu.NewTextureFromRgba(rgba, func(t *giu.Texture) {
+		texture = t
+		close(done)
+	})
+	<-done
+
+	if texture == nil {
+		return nil, errTextureCreationFailed
+	}
+	return texture, nil
+}
@@ -0,0 +1,160 @@
+package gui
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/AllenDang/giu"
+
+	"github.com/Advik-B/The-Blackbook-Archive/images"
+)
+
+// fullCoverMaxSize is the longest side, in pixels, a full-size cover is
+// scaled to for the viewer - large enough to show real detail over the
+// results list's thumbnailSize, without holding an arbitrarily huge source
+// image at full resolution once decoded.
+const fullCoverMaxSize = 640
+
+// coverViewerState tracks the full-size cover popup opened from the
+// details pane: which URL it's for, how the fetch is progressing, and the
+// resulting texture once decoded. A nil *coverViewerState on App means the
+// viewer is closed.
+type coverViewerState struct {
+	coverURL string
+	texture  *giu.Texture
+	err      error
+	read     int64
+	total    int64
+	done     bool
+}
+
+// openCoverViewer starts fetching coverURL at full resolution for the
+// cover viewer, replacing any viewer already open. The fetch runs in the
+// background and reports its progress back through state so
+// coverViewerWidget can show a running byte count while it's in flight.
+func (a *App) openCoverViewer(coverURL string) {
+	state := &coverViewerState{coverURL: coverURL}
+	a.coverViewer = state
+
+	go func() {
+		maxBytes := a.cfg.MaxCoverImageBytes
+		if maxBytes <= 0 {
+			maxBytes = defaultMaxCoverImageBytes
+		}
+		timeout := defaultImageFetchTimeout
+		if a.cfg.ImageFetchTimeoutSeconds > 0 {
+			timeout = time.Duration(a.cfg.ImageFetchTimeoutSeconds) * time.Second
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		img, err := images.FetchWithProgress(ctx, nil, coverURL, maxBytes, func(read, total int64) {
+			state.read, state.total = read, total
+			giu.Update()
+		})
+		if a.coverViewer != state {
+			return // viewer was closed or replaced mid-fetch
+		}
+		if err != nil {
+			state.err = err
+			state.done = true
+			giu.Update()
+			return
+		}
+
+		texture, err := textureFromImageFit(img, fullCoverMaxSize)
+		if a.coverViewer != state {
+			return
+		}
+		state.texture = texture
+		state.err = err
+		state.done = true
+		giu.Update()
+	}()
+}
+
+// closeCoverViewer closes the full-size cover popup, if open.
+func (a *App) closeCoverViewer() {
+	a.coverViewer = nil
+}
+
+// saveCoverViewerImage re-downloads the open viewer's cover straight to
+// the configured download directory, under the active book's title -
+// simpler and more faithful to the source than re-encoding the decoded,
+// fit-to-viewer texture back out to a file.
+func (a *App) saveCoverViewerImage() {
+	state := a.coverViewer
+	if state == nil || a.activeDetails == nil {
+		return
+	}
+
+	req, err := http.NewRequest(http.MethodGet, state.coverURL, nil)
+	if err != nil {
+		a.status = "Failed to save cover: " + err.Error()
+		return
+	}
+
+	destPath := a.downloadDestPath(a.cfg.DownloadDir, a.activeDetails.Title+" cover", a.activeDetails.Author, a.activeDetails.ID, coverFileExtension(state.coverURL))
+	if !a.confirmOverwrite(destPath) {
+		return
+	}
+	if err := a.downloader.Download(req, destPath); err != nil {
+		a.status = "Failed to save cover: " + err.Error()
+		return
+	}
+	a.status = "Cover saved to " + destPath
+}
+
+// coverFileExtension guesses a cover's file extension from its URL path,
+// falling back to "jpg" - the format most covers are actually served in -
+// when the URL doesn't carry a recognizable one.
+func coverFileExtension(coverURL string) string {
+	ext := strings.ToLower(strings.TrimPrefix(path.Ext(path.Base(coverURL)), "."))
+	switch ext {
+	case "jpg", "jpeg", "png", "gif", "webp":
+		return ext
+	default:
+		return "jpg"
+	}
+}
+
+// coverViewerWidget renders the full-size cover popup's current state - a
+// progress readout while the fetch is in flight, the image itself once
+// decoded, or an error - alongside the actions to save it or close the
+// popup. Returns an empty widget when no viewer is open.
+func (a *App) coverViewerWidget() giu.Widget {
+	state := a.coverViewer
+	if state == nil {
+		return giu.Row()
+	}
+
+	var body giu.Widget
+	switch {
+	case state.err != nil:
+		body = giu.Label("Failed to load full-size cover: " + state.err.Error())
+	case !state.done:
+		if state.total > 0 {
+			body = giu.Label(fmt.Sprintf("Loading full-size cover... %d / %d bytes", state.read, state.total))
+		} else {
+			body = giu.Label(fmt.Sprintf("Loading full-size cover... %d bytes", state.read))
+		}
+	default:
+		body = giu.Image(state.texture)
+	}
+
+	actions := []giu.Widget{giu.Button("Close").OnClick(a.closeCoverViewer)}
+	if state.done && state.err == nil {
+		actions = append([]giu.Widget{giu.Button("Save cover...").OnClick(a.saveCoverViewerImage)}, actions...)
+	}
+
+	return giu.Column(
+		giu.Separator(),
+		body,
+		giu.Row(actions...),
+	)
+}
@@ -0,0 +1,50 @@
+package gui
+
+import (
+	"strings"
+
+	"github.com/AllenDang/giu"
+
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+// descriptionWidget renders a book's description, preferring the
+// structured DescriptionRich form (one wrapped, optionally bulleted label
+// per paragraph/list item, with emphasis shown via lightweight markdown-
+// style markers) over the plain Description field, which is kept only as
+// a fallback for pages the rich parser found no structure in.
+func descriptionWidget(d *zlibrary.BookDetails) giu.Widget {
+	if len(d.DescriptionRich) == 0 {
+		if d.Description == "" {
+			return giu.Row()
+		}
+		return giu.Label("Description: " + d.Description)
+	}
+
+	blocks := make([]giu.Widget, 0, len(d.DescriptionRich))
+	for _, block := range d.DescriptionRich {
+		blocks = append(blocks, giu.Label(descriptionBlockText(block)))
+	}
+	return giu.Column(blocks...)
+}
+
+// descriptionBlockText flattens one DescriptionBlock into a single line:
+// a "- " bullet for list items, and **bold**/_italic_ markers around runs
+// that carried that emphasis in the source markup.
+func descriptionBlockText(block zlibrary.DescriptionBlock) string {
+	var b strings.Builder
+	if block.ListItem {
+		b.WriteString("- ")
+	}
+	for _, run := range block.Runs {
+		switch {
+		case run.Bold:
+			b.WriteString("**" + run.Text + "**")
+		case run.Italic:
+			b.WriteString("_" + run.Text + "_")
+		default:
+			b.WriteString(run.Text)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,78 @@
+package gui
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/atotto/clipboard"
+
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+// copyDetailsMarkdown copies the active book's details pane as a Markdown
+// document to the system clipboard, for pasting into notes.
+func (a *App) copyDetailsMarkdown() {
+	d := a.activeDetails
+	if d == nil {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := zlibrary.ExportDetailsMarkdown(d, &buf); err != nil {
+		a.status = "Failed to export details: " + err.Error()
+		return
+	}
+	if err := clipboard.WriteAll(buf.String()); err != nil {
+		a.status = "Failed to copy to clipboard: " + err.Error()
+		return
+	}
+	a.status = "Details copied as Markdown."
+}
+
+// copyDetailsJSON copies the active book's details as the stable JSON
+// document described by zlibrary.ExportDetailsJSON, for a user who wants to
+// pipe it into another tool rather than read it themselves.
+func (a *App) copyDetailsJSON() {
+	d := a.activeDetails
+	if d == nil {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := zlibrary.ExportDetailsJSON(d, &buf); err != nil {
+		a.status = "Failed to export details: " + err.Error()
+		return
+	}
+	if err := clipboard.WriteAll(buf.String()); err != nil {
+		a.status = "Failed to copy to clipboard: " + err.Error()
+		return
+	}
+	a.status = "Details copied as JSON."
+}
+
+// exportDetailsFile saves the active book's details pane to a Markdown
+// file under the configured download directory, named after the book.
+// There's no native save-file dialog wired into this UI yet, so it lands
+// in the same directory downloads already go to rather than prompting for
+// one.
+func (a *App) exportDetailsFile() {
+	d := a.activeDetails
+	if d == nil {
+		return
+	}
+
+	destPath := a.downloadDestPath(a.cfg.DownloadDir, d.Title, d.Author, d.ID, "md")
+
+	f, err := os.Create(destPath)
+	if err != nil {
+		a.status = "Failed to export details: " + err.Error()
+		return
+	}
+	defer f.Close()
+
+	if err := zlibrary.ExportDetailsMarkdown(d, f); err != nil {
+		a.status = "Failed to export details: " + err.Error()
+		return
+	}
+	a.status = "Exported details to " + destPath
+}
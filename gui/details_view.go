@@ -0,0 +1,843 @@
+package gui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	"github.com/AllenDang/giu"
+	"github.com/atotto/clipboard"
+
+	"github.com/Advik-B/The-Blackbook-Archive/utils"
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+// openDetails fetches a book's details, runs optional Open Library
+// enrichment over the result, and stashes both for the details pane to
+// render.
+func (a *App) openDetails(result zlibrary.BookSearchResult) {
+	provider, ok := a.providerFor(result.Source)
+	if !ok {
+		a.status = "Unknown source: " + result.Source
+		return
+	}
+
+	atomic.AddInt32(&a.userFetchActive, 1)
+	defer atomic.AddInt32(&a.userFetchActive, -1)
+	a.closeCoverViewer()
+
+	details, err := provider.Details(context.Background(), result.DetailsURL)
+	if err != nil {
+		if errors.Is(err, zlibrary.ErrBookRemoved) {
+			a.unavailableResult = &result
+			a.status = "This book has been removed or is no longer available."
+			return
+		}
+		if cached := a.cachedDetailsFallback(result.DetailsURL); cached != nil {
+			a.unavailableResult = nil
+			a.activeDetails = cached
+			a.activeDetailsIsCached = true
+			a.enrichedFields = nil
+			a.comments = nil
+			a.commentsPage = 0
+			a.showComments = false
+			a.status = "Couldn't reach the server (" + err.Error() + ") - showing cached data."
+			return
+		}
+		a.status = "Failed to load details: " + err.Error()
+		return
+	}
+
+	a.unavailableResult = nil
+	enrichResult := a.enricher.Enrich(details)
+	a.enrichedFields = enrichResult.Sources
+	a.activeDetails = details
+	a.activeDetailsIsCached = false
+	a.comments = nil
+	a.commentsPage = 0
+	a.showComments = false
+	a.status = "Ready."
+
+	if a.catalog != nil {
+		a.catalog.SaveDetails(details)
+		a.catalog.RecordView(details.BookSearchResult)
+	}
+}
+
+// cachedDetailsFallback returns the catalog's cached copy of detailsURL's
+// details, for offline-first fallback after a live fetch fails for
+// reasons other than the book being confirmed gone (ErrBookRemoved). nil
+// when there's no catalog, or nothing cached for this book yet.
+func (a *App) cachedDetailsFallback(detailsURL string) *zlibrary.BookDetails {
+	if a.catalog == nil {
+		return nil
+	}
+	cached, err := a.catalog.GetDetails(detailsURL)
+	if err != nil {
+		return nil
+	}
+	return cached
+}
+
+// openDetailsFromURL validates a.bookURLInput as a Z-Library book page and
+// loads its details directly, bypassing search entirely - useful for a
+// URL pasted in from a browser or another device.
+func (a *App) openDetailsFromURL() {
+	client := a.zlibClient()
+	if client == nil {
+		a.status = "Opening a book URL directly is only supported for Z-Library."
+		return
+	}
+
+	normalized, err := client.NormalizeBookURL(a.bookURLInput)
+	if err != nil {
+		a.status = err.Error()
+		return
+	}
+
+	a.openDetails(zlibrary.BookSearchResult{
+		Source:     zlibrary.SourceName,
+		DetailsURL: normalized,
+	})
+
+	// A successful open replaces the results list with a single synthetic
+	// entry for the opened book, so the context menu, compare mode, and
+	// "hide from results" all have something consistent to act on, the
+	// same as they would for a result that came from a real search.
+	if a.activeDetails != nil && a.activeDetails.DetailsURL == normalized {
+		a.results = []zlibrary.BookSearchResult{a.activeDetails.BookSearchResult}
+		a.lastSearchPage = 1
+		a.selected = make(map[string]zlibrary.BookSearchResult)
+		a.invalidateResultRowCache()
+	}
+}
+
+// detailsPaneWidget renders the active book's details, tagging any field
+// that enrichment filled in with an "(Open Library)" marker.
+func (a *App) detailsPaneWidget() giu.Widget {
+	if a.activeDetails == nil {
+		return giu.Row()
+	}
+	d := a.activeDetails
+
+	widgets := []giu.Widget{}
+	if a.activeDetailsIsCached {
+		widgets = append(widgets, giu.Label("Showing cached data - couldn't reach the server for an up-to-date copy."))
+	}
+	widgets = append(widgets,
+		a.thumbnailWidget(d.CoverURL),
+	)
+	if d.CoverURL != "" {
+		widgets = append(widgets, giu.Button("View full-size cover").OnClick(func() { a.openCoverViewer(d.CoverURL) }))
+	}
+	widgets = append(widgets, a.coverViewerWidget())
+	widgets = append(widgets,
+		giu.Label(d.Title),
+		a.authorsWidget(d),
+		a.fieldLabel("Publisher", d.Publisher),
+		a.fieldLabel("Year", d.Year),
+		a.fieldLabel("Pages", pagesText(d.Pages)),
+		a.fieldLabel("Edition", d.Edition),
+		a.fieldLabel("ISBN-10", isbnText(d.ISBN10)),
+		a.fieldLabel("ISBN-13", isbnText(d.ISBN13)),
+		a.fieldLabel("Series", seriesText(d.Series, d.SeriesIndex)),
+		a.fieldLabel("Categories", categoriesText(d.Categories)),
+		descriptionWidget(d),
+	)
+	if notice := availabilityNoticeText(d); notice != "" {
+		widgets = append(widgets, giu.Label(notice))
+	}
+	widgets = append(widgets, giu.Button(a.buildDownloadButtonText(d)).OnClick(a.downloadActiveDetails).Tooltip(a.downloadButtonTooltip(d)))
+	if canDownload(d) {
+		widgets = append(widgets, giu.Button("Download As...").OnClick(a.downloadActiveDetailsAs))
+	}
+	widgets = append(widgets, a.downloadAsWidget())
+	if !canDownload(d) {
+		widgets = append(widgets, giu.Label("This book only offers formats that require local conversion. Download one below, then use Convert."))
+	}
+	if len(d.OtherFormats) > 0 {
+		widgets = append(widgets, a.otherFormatsWidget(d.OtherFormats))
+		widgets = append(widgets, giu.Button("Download all formats as .zip").OnClick(a.downloadAllFormatsZip))
+	}
+	if d.IpfsCID != "" {
+		widgets = append(widgets, a.ipfsWidget(d))
+	}
+	widgets = append(widgets, a.commentsWidget(d))
+	if d.SeriesURL != nil {
+		seriesURL := *d.SeriesURL
+		widgets = append(widgets, giu.Button("View series").OnClick(func() { a.openSeries(seriesURL) }))
+	}
+	widgets = append(widgets,
+		giu.Button("Convert to EPUB").OnClick(func() { a.convertLastDownload("epub") }),
+		giu.Button("Copy BibTeX citation").OnClick(a.copyBibTeX),
+		giu.Button("Copy as Markdown").OnClick(a.copyDetailsMarkdown),
+		giu.Button("Copy as JSON").OnClick(a.copyDetailsJSON),
+		giu.Button("Copy share link").OnClick(func() { a.copyShareLink(d.BookSearchResult) }),
+		giu.Button("Export...").OnClick(a.exportDetailsFile),
+		giu.Button("Send to e-reader").OnClick(a.sendActiveDetailsToEmail),
+		giu.Button(savedStarLabel(a.savedBookIDs, d.ID)).OnClick(a.toggleActiveDetailsSaved),
+	)
+
+	return giu.Column(widgets...)
+}
+
+// otherFormatsWidget renders one row per alternate format, each showing
+// its known (or probed) size and a download button, letting a user pick a
+// smaller alternative without committing to the primary format first.
+func (a *App) otherFormatsWidget(formats []zlibrary.FormatInfo) giu.Widget {
+	rows := make([]giu.Widget, 0, len(formats))
+	for i := range formats {
+		f := formats[i]
+
+		row := []giu.Widget{giu.Label(f.Format), giu.Label(a.formatSizeLabel(f))}
+		if f.ConversionOnly {
+			row = append(row, giu.Button("Request conversion").OnClick(func() { a.requestFormatConversion(f.Format) }))
+		} else {
+			row = append(row, giu.Button("Download "+f.Format).OnClick(func() { a.downloadFormat(f.Format) }))
+			row = append(row, giu.Button("As...").OnClick(func() { a.beginDownloadAs(f.Format) }))
+			if f.SizeBytes == 0 {
+				row = append(row, giu.Button("Check size").OnClick(func() { a.probeFormatSize(f) }))
+			}
+		}
+		rows = append(rows, giu.Row(row...))
+	}
+	return giu.Column(rows...)
+}
+
+// formatSizeLabel returns a format's size for display: the scraped size if
+// there is one, otherwise a previously probed size (see probeFormatSize),
+// otherwise "unknown".
+func (a *App) formatSizeLabel(f zlibrary.FormatInfo) string {
+	if f.SizeText != "" {
+		return f.SizeText
+	}
+
+	a.formatSizesMu.Lock()
+	probed, ok := a.formatSizes[f.URL]
+	a.formatSizesMu.Unlock()
+	if ok {
+		return "~" + utils.FormatBytesWith(probed, a.cfg.ByteFormatOptions())
+	}
+	return "unknown"
+}
+
+// probeFormatSize learns a format's size via a HEAD preflight when the
+// scraped page didn't carry one, run lazily only once the user asks for
+// it rather than for every alternate format up front.
+func (a *App) probeFormatSize(f zlibrary.FormatInfo) {
+	if f.URL == "" {
+		return
+	}
+	go func() {
+		req, err := http.NewRequest(http.MethodGet, f.URL, nil)
+		if err != nil {
+			return
+		}
+		preflight, err := a.downloader.PreflightRequest(context.Background(), req)
+		if err != nil || preflight.SizeBytes <= 0 {
+			return
+		}
+
+		a.formatSizesMu.Lock()
+		a.formatSizes[f.URL] = preflight.SizeBytes
+		a.formatSizesMu.Unlock()
+		giu.Update()
+	}()
+}
+
+// downloadFormat downloads the active book in the given format, the same
+// way downloadActiveDetails downloads the primary one, saving to the
+// automatically generated path.
+func (a *App) downloadFormat(format string) {
+	a.downloadFormatTo(format, "")
+}
+
+// downloadFormatTo is downloadFormat with an optional destPath override
+// from the "Download As..." picker (see download_as.go). An empty destPath
+// falls back to the automatically generated one.
+func (a *App) downloadFormatTo(format, destPath string) {
+	d := a.activeDetails
+	if d == nil {
+		return
+	}
+
+	provider, ok := a.providerFor(d.Source)
+	if !ok {
+		a.status = "Unknown source: " + d.Source
+		return
+	}
+
+	req, err := provider.DownloadRequest(context.Background(), d, format)
+	if err != nil {
+		a.status = "Download failed: " + err.Error() + ipfsFallbackHint(d)
+		return
+	}
+
+	if preflight, err := a.downloader.PreflightRequest(context.Background(), req); err == nil {
+		if a.cfg.MaxDownloadSizeBytes > 0 && preflight.SizeBytes > a.cfg.MaxDownloadSizeBytes {
+			a.status = fmt.Sprintf("Refusing to start: %s exceeds the configured limit.", utils.FormatBytesWith(preflight.SizeBytes, a.cfg.ByteFormatOptions()))
+			return
+		}
+	}
+
+	if destPath == "" {
+		destPath = a.downloadDestPath(a.cfg.DownloadDir, d.Title, d.Author, d.ID, format)
+	} else {
+		os.MkdirAll(filepath.Dir(destPath), 0o755)
+	}
+
+	if !a.confirmOverwrite(destPath) {
+		return
+	}
+
+	atomic.AddInt32(&a.activeDownloads, 1)
+	defer atomic.AddInt32(&a.activeDownloads, -1)
+
+	if err := a.downloader.Download(req, destPath); err != nil {
+		a.status = "Download failed: " + err.Error() + ipfsFallbackHint(d)
+		return
+	}
+	a.finishDownload(destPath, format, d.Title, d.Author, "Downloaded to "+destPath)
+}
+
+// ipfsFallbackHint points a user at the IPFS download option after the
+// primary download fails, when one is available - it's easy to miss
+// otherwise, tucked below the main download button.
+func ipfsFallbackHint(d *zlibrary.BookDetails) string {
+	if d.IpfsCID == "" {
+		return ""
+	}
+	return " This book is also available via IPFS below."
+}
+
+// ipfsWidget renders a book's IPFS content identifiers, a gateway picker,
+// and a button to download through whichever gateway is selected.
+func (a *App) ipfsWidget(d *zlibrary.BookDetails) giu.Widget {
+	client := a.zlibClient()
+	if client == nil {
+		return giu.Row()
+	}
+
+	gatewayURLs := client.IPFSGatewayURLs(d.IpfsCID)
+	if len(gatewayURLs) == 0 {
+		return giu.Row()
+	}
+	if int(a.activeIPFSGatewayIdx) >= len(gatewayURLs) {
+		a.activeIPFSGatewayIdx = 0
+	}
+
+	widgets := []giu.Widget{
+		giu.Label("IPFS CID: " + d.IpfsCID),
+		giu.Button("Copy CID").OnClick(func() { clipboard.WriteAll(d.IpfsCID) }),
+	}
+	if d.IpfsCIDBlake2b != "" {
+		widgets = append(widgets,
+			giu.Label("IPFS CID (blake2b): "+d.IpfsCIDBlake2b),
+			giu.Button("Copy CID (blake2b)").OnClick(func() { clipboard.WriteAll(d.IpfsCIDBlake2b) }),
+		)
+	}
+	widgets = append(widgets,
+		giu.Combo("Gateway", gatewayURLs[a.activeIPFSGatewayIdx], gatewayURLs, &a.activeIPFSGatewayIdx),
+		giu.Button("Download via IPFS").OnClick(func() { a.downloadViaIPFS(d, gatewayURLs) }),
+	)
+
+	return giu.Column(widgets...)
+}
+
+// downloadViaIPFS fetches d through whichever gateway in gatewayURLs is
+// currently selected, the IPFS equivalent of downloadFormat.
+func (a *App) downloadViaIPFS(d *zlibrary.BookDetails, gatewayURLs []string) {
+	idx := int(a.activeIPFSGatewayIdx)
+	if idx < 0 || idx >= len(gatewayURLs) {
+		idx = 0
+	}
+
+	req, err := http.NewRequest(http.MethodGet, gatewayURLs[idx], nil)
+	if err != nil {
+		a.status = "IPFS download failed: " + err.Error()
+		return
+	}
+
+	destPath := a.downloadDestPath(a.cfg.DownloadDir, d.Title, d.Author, d.ID, d.Format)
+	if !a.confirmOverwrite(destPath) {
+		return
+	}
+
+	atomic.AddInt32(&a.activeDownloads, 1)
+	defer atomic.AddInt32(&a.activeDownloads, -1)
+
+	if err := a.downloader.Download(req, destPath); err != nil {
+		a.status = "IPFS download failed: " + err.Error()
+		return
+	}
+	a.finishDownload(destPath, d.Format, d.Title, d.Author, "Downloaded via IPFS to "+destPath)
+}
+
+// requestFormatConversion asks the site to convert the active book to
+// format, confirming first since conversions count against a limited
+// daily quota - the same "click again to confirm" pattern ConfirmClose
+// uses for closing with downloads in flight.
+func (a *App) requestFormatConversion(format string) {
+	d := a.activeDetails
+	if d == nil {
+		return
+	}
+
+	if a.pendingConversionFormat != format {
+		a.pendingConversionFormat = format
+		a.status = fmt.Sprintf("Click \"Request conversion\" again to confirm converting to %s.", format)
+		return
+	}
+	a.pendingConversionFormat = ""
+
+	client := a.zlibClient()
+	if client == nil {
+		a.status = "Conversion is only available for Z-Library."
+		return
+	}
+
+	a.status = "Converting..."
+	go a.runFormatConversion(client, d.ID, format)
+}
+
+// runFormatConversion drives a requested conversion to completion on its
+// own goroutine, so the poll loop inside PollConversion doesn't block the
+// render loop.
+func (a *App) runFormatConversion(client *zlibrary.Client, bookID, format string) {
+	ticket, err := client.RequestConversion(context.Background(), bookID, format)
+	if err == nil {
+		var status zlibrary.ConversionStatus
+		var downloadURL string
+		status, downloadURL, err = client.PollConversion(context.Background(), ticket)
+		if err == nil && status == zlibrary.ConversionReady {
+			a.status = "Conversion ready: " + downloadURL
+			giu.Update()
+			return
+		}
+	}
+
+	switch {
+	case errors.Is(err, zlibrary.ErrLoginRequired):
+		a.status = "Sign in to Z-Library to request a conversion."
+	case errors.Is(err, zlibrary.ErrConversionLimitReached):
+		a.status = "Daily conversion limit reached - try again tomorrow."
+	case err != nil:
+		a.status = "Conversion failed: " + err.Error()
+	default:
+		a.status = "Conversion failed."
+	}
+	giu.Update()
+}
+
+// commentsWidget renders a collapsible section for the active book's
+// comments, loading the first page lazily the first time it's expanded and
+// offering a "load more" button for each page after that.
+func (a *App) commentsWidget(d *zlibrary.BookDetails) giu.Widget {
+	return giu.Column(
+		giu.Checkbox("Comments", &a.showComments).OnChange(func() {
+			if a.showComments && a.commentsPage == 0 {
+				a.loadMoreComments(d)
+			}
+		}),
+		giu.Custom(func() {
+			if !a.showComments {
+				return
+			}
+
+			rows := make([]giu.Widget, 0, len(a.comments)+1)
+			if len(a.comments) == 0 {
+				rows = append(rows, giu.Label("No comments yet."))
+			}
+			for _, c := range a.comments {
+				rows = append(rows, giu.Label(commentLine(c)))
+			}
+			rows = append(rows, giu.Button("Load more").OnClick(func() { a.loadMoreComments(d) }))
+
+			giu.Column(rows...).Build()
+		}),
+	)
+}
+
+// commentLine renders one Comment as a single display line.
+func commentLine(c zlibrary.Comment) string {
+	if c.Rating != nil {
+		return fmt.Sprintf("%s (%s, %d*): %s", c.Author, c.Date, *c.Rating, c.Text)
+	}
+	return fmt.Sprintf("%s (%s): %s", c.Author, c.Date, c.Text)
+}
+
+// loadMoreComments fetches the next page of comments for d and appends it
+// to a.comments.
+func (a *App) loadMoreComments(d *zlibrary.BookDetails) {
+	client := a.zlibClient()
+	if client == nil {
+		a.status = "Comments are only available for Z-Library."
+		return
+	}
+
+	a.commentsPage++
+	page, err := client.GetBookComments(context.Background(), d.DetailsURL, a.commentsPage)
+	if err != nil {
+		a.status = "Failed to load comments: " + err.Error()
+		a.commentsPage--
+		return
+	}
+	a.comments = append(a.comments, page...)
+}
+
+// availabilityNoticeText renders d's Availability as a prominent message
+// for the details pane, distinct from the generic "only conversion
+// available" case below - premium-only and region-blocked notices carry
+// the page's own wording rather than a made-up explanation.
+func availabilityNoticeText(d *zlibrary.BookDetails) string {
+	switch d.Availability {
+	case zlibrary.AvailabilityPremiumOnly:
+		return "Premium required: " + d.AvailabilityNotice
+	case zlibrary.AvailabilityRegionBlocked:
+		return "Not available in your region: " + d.AvailabilityNotice
+	default:
+		return ""
+	}
+}
+
+// downloadButtonTooltip explains why the download button might not do
+// what a user expects: an availability notice takes priority over the
+// daily soft-limit warning, since it's the more actionable of the two.
+func (a *App) downloadButtonTooltip(d *zlibrary.BookDetails) string {
+	if notice := availabilityNoticeText(d); notice != "" {
+		return notice
+	}
+	return a.downloadSoftLimitTooltip()
+}
+
+// canDownload reports whether d has at least one format that can be
+// fetched directly, as opposed to only formats that require local
+// conversion from another format (see FormatInfo.ConversionOnly).
+func canDownload(d *zlibrary.BookDetails) bool {
+	if d.DownloadURL != "" {
+		return true
+	}
+	for _, f := range d.OtherFormats {
+		if !f.ConversionOnly {
+			return true
+		}
+	}
+	return false
+}
+
+// buildDownloadButtonText picks the download button's label, so a book
+// with only conversion-only formats doesn't show a plain disabled-looking
+// "Download" that gives no indication of why it won't work, and so a
+// preferred-format pick other than the primary format is visible before
+// the click.
+func (a *App) buildDownloadButtonText(d *zlibrary.BookDetails) string {
+	if !canDownload(d) {
+		return "Only conversion available"
+	}
+	format := a.primaryDownloadFormat(d)
+	if format == "" || format == d.Format {
+		return "Download"
+	}
+	return "Download " + format
+}
+
+// primaryDownloadFormat is the format downloadActiveDetails and
+// buildDownloadButtonText will actually use: the user's preferred format
+// if one is set and available, otherwise d.Format.
+func (a *App) primaryDownloadFormat(d *zlibrary.BookDetails) string {
+	client := a.zlibClient()
+	if client == nil {
+		return d.Format
+	}
+	return client.PreferredDownloadFormat(d)
+}
+
+// copyBibTeX copies a BibTeX citation for the active book to the system
+// clipboard.
+func (a *App) copyBibTeX() {
+	if a.activeDetails == nil {
+		return
+	}
+	if err := clipboard.WriteAll(a.activeDetails.BibTeX()); err != nil {
+		a.status = "Failed to copy citation: " + err.Error()
+		return
+	}
+	a.status = "BibTeX citation copied to clipboard."
+}
+
+// downloadActiveDetails downloads the book currently shown in the details
+// pane in its primary format, refusing to start if only conversion-only
+// formats are available.
+func (a *App) downloadActiveDetails() {
+	d := a.activeDetails
+	if d == nil {
+		return
+	}
+	if !canDownload(d) {
+		a.status = "This book only offers formats that require local conversion."
+		return
+	}
+	a.downloadFormat(a.primaryDownloadFormat(d))
+}
+
+// downloadActiveDetailsAs opens the "Download As..." picker for the active
+// book's primary format, the custom-path counterpart to
+// downloadActiveDetails.
+func (a *App) downloadActiveDetailsAs() {
+	d := a.activeDetails
+	if d == nil || !canDownload(d) {
+		return
+	}
+	a.beginDownloadAs(a.primaryDownloadFormat(d))
+}
+
+// convertLastDownload converts the most recently downloaded file to
+// targetFormat using the locally installed conversion tool.
+func (a *App) convertLastDownload(targetFormat string) {
+	if a.lastDownloadPath == "" {
+		a.status = "Nothing downloaded yet to convert."
+		return
+	}
+	if !a.converter.Available() {
+		a.status = "No local ebook conversion tool found."
+		return
+	}
+
+	destPath := strings.TrimSuffix(a.lastDownloadPath, filepath.Ext(a.lastDownloadPath)) + "." + targetFormat
+	if err := a.converter.Convert(context.Background(), a.lastDownloadPath, destPath); err != nil {
+		a.status = "Conversion failed: " + err.Error()
+		return
+	}
+	a.status = "Converted to " + destPath
+}
+
+// sendActiveDetailsToEmail sends the book currently shown in the details
+// pane to the account's configured send-to-email/Kindle address. There is
+// no login flow yet, so this always reports that a session is required -
+// the button stays in place, ready to work once one exists.
+func (a *App) sendActiveDetailsToEmail() {
+	d := a.activeDetails
+	if d == nil {
+		return
+	}
+
+	client := a.zlibClient()
+	if client == nil {
+		a.status = "Send to e-reader is only available for Z-Library."
+		return
+	}
+
+	if err := client.SendToEmail(d.ID, d.Format); err != nil {
+		switch {
+		case errors.Is(err, zlibrary.ErrLoginRequired):
+			a.status = "Sign in to Z-Library to send books to your e-reader."
+		case errors.Is(err, zlibrary.ErrSendNotConfigured):
+			a.status = "No send-to-email address is configured on this account."
+		case errors.Is(err, zlibrary.ErrSendLimitReached):
+			a.status = "Daily send-to-email limit reached - try again tomorrow."
+		default:
+			a.status = "Send to e-reader failed: " + err.Error()
+		}
+		return
+	}
+	a.status = "Sent to your e-reader."
+}
+
+// toggleActiveDetailsSaved saves the book currently shown in the details
+// pane to the Z-Library account, or removes it if it's already saved.
+// There is no login flow yet, so this always reports that a session is
+// required - the toggle stays in place, ready to work once one exists.
+func (a *App) toggleActiveDetailsSaved() {
+	d := a.activeDetails
+	if d == nil {
+		return
+	}
+
+	client := a.zlibClient()
+	if client == nil {
+		a.status = "Saving books is only available for Z-Library."
+		return
+	}
+
+	var err error
+	if a.savedBookIDs[d.ID] {
+		err = client.RemoveFromAccount(d.ID)
+	} else {
+		err = client.SaveToAccount(d.ID)
+	}
+	if err != nil {
+		if errors.Is(err, zlibrary.ErrLoginRequired) {
+			a.status = "Sign in to Z-Library to save books to your account."
+		} else {
+			a.status = "Failed to update saved books: " + err.Error()
+		}
+		return
+	}
+
+	if a.savedBookIDs[d.ID] {
+		delete(a.savedBookIDs, d.ID)
+		a.status = "Removed from saved books."
+		return
+	}
+	a.savedBookIDs[d.ID] = true
+	a.status = "Saved to your account."
+}
+
+// savedStarLabel picks the save-toggle button's label so a saved book
+// shows a filled star rather than a button indistinguishable from an
+// unsaved one.
+func savedStarLabel(saved map[string]bool, bookID string) string {
+	if saved[bookID] {
+		return "★ Saved"
+	}
+	return "☆ Save"
+}
+
+// openSavedBooks loads the account's personal saved-books list into the
+// results list. There is no login flow yet, so this always reports that a
+// session is required.
+func (a *App) openSavedBooks() {
+	client := a.zlibClient()
+	if client == nil {
+		a.status = "Saved books are only available for Z-Library."
+		return
+	}
+
+	results, err := client.GetSavedBooks(context.Background(), 1)
+	if err != nil {
+		if errors.Is(err, zlibrary.ErrLoginRequired) {
+			a.status = "Sign in to Z-Library to see your saved books."
+		} else {
+			a.status = "Failed to load saved books: " + err.Error()
+		}
+		return
+	}
+
+	a.results = results
+	a.selected = make(map[string]zlibrary.BookSearchResult)
+	a.status = "Ready."
+}
+
+// pagesText renders an optional page count as plain text, since it's
+// stored as a *string (nil meaning "not known").
+func pagesText(pages *string) string {
+	if pages == nil {
+		return ""
+	}
+	return *pages
+}
+
+// isbnText renders an already-normalized ISBN (see
+// zlibrary.getBookDetailsUncached, which runs both ISBN fields through
+// utils.NormalizeISBNPair before they ever reach the UI) in its hyphenated
+// form for display.
+func isbnText(isbn string) string {
+	if isbn == "" {
+		return ""
+	}
+	return utils.HyphenateISBN(isbn)
+}
+
+// seriesText renders a series name and, when known, its index as
+// "Name (Book N)" for display.
+func seriesText(series string, index *string) string {
+	if series == "" {
+		return ""
+	}
+	if index == nil {
+		return series
+	}
+	return fmt.Sprintf("%s (Book %s)", series, *index)
+}
+
+// categoriesText renders a book's categories as comma-separated breadcrumbs,
+// e.g. "Fiction > Science Fiction, Fiction > Fantasy", so a category's place
+// in the hierarchy is visible even in a single-line label.
+func categoriesText(categories []zlibrary.Category) string {
+	if len(categories) == 0 {
+		return ""
+	}
+
+	parts := make([]string, 0, len(categories))
+	for _, cat := range categories {
+		if cat.Parent != nil {
+			parts = append(parts, fmt.Sprintf("%s > %s", *cat.Parent, cat.Name))
+			continue
+		}
+		parts = append(parts, cat.Name)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// openSeries loads every volume of the book currently shown in the
+// details pane's series into the results list. Series browsing is a
+// Z-Library-specific feature, so it talks to the concrete client rather
+// than going through the Provider abstraction.
+func (a *App) openSeries(seriesURL string) {
+	client := a.zlibClient()
+	if client == nil {
+		a.status = "Series browsing is only available for Z-Library."
+		return
+	}
+
+	results, name, err := client.GetSeriesBooks(context.Background(), seriesURL)
+	if err != nil {
+		a.status = "Failed to load series: " + err.Error()
+		return
+	}
+
+	a.results = results
+	a.selected = make(map[string]zlibrary.BookSearchResult)
+	if name != "" {
+		a.status = "Showing series: " + name
+	} else {
+		a.status = "Ready."
+	}
+}
+
+// authorsWidget renders a book's credited authors as "By" followed by one
+// clickable link per author that opens their author page in the system
+// browser, comma-separated. Falls back to a plain label when the scraper
+// found no per-author markup to link (or no authors at all).
+func (a *App) authorsWidget(d *zlibrary.BookDetails) giu.Widget {
+	if len(d.Authors) == 0 {
+		return giu.Label("By " + utils.FormatAuthors(d.Author))
+	}
+
+	row := []giu.Widget{giu.Label("By")}
+	for i, author := range d.Authors {
+		author := author
+		if author.URL != nil {
+			row = append(row, giu.Button(author.Name).OnClick(func() { a.openAuthorInBrowser(*author.URL) }))
+		} else {
+			row = append(row, giu.Label(author.Name))
+		}
+		if i < len(d.Authors)-1 {
+			row = append(row, giu.Label(","))
+		}
+	}
+	return giu.Row(row...)
+}
+
+// openAuthorInBrowser opens a credited author's page in the system's
+// default browser.
+func (a *App) openAuthorInBrowser(authorURL string) {
+	if err := openInBrowser(authorURL); err != nil {
+		a.status = "Failed to open browser: " + err.Error()
+	}
+}
+
+func (a *App) fieldLabel(field, value string) giu.Widget {
+	if value == "" {
+		return giu.Row()
+	}
+	if source, ok := a.enrichedFields[field]; ok {
+		return giu.Label(fmt.Sprintf("%s: %s (%s)", field, value, source))
+	}
+	return giu.Label(fmt.Sprintf("%s: %s", field, value))
+}
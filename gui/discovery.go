@@ -0,0 +1,55 @@
+package gui
+
+import (
+	"context"
+
+	"github.com/AllenDang/giu"
+)
+
+// popularTermsWidget renders the discovery sidebar shown in place of a
+// blank results list: a row of clickable chips for the site's popular
+// search terms, each running that search on click. Terms are fetched at
+// most once per session; if scraping them fails or turns up nothing, the
+// sidebar is just empty rather than showing an error in its place.
+func (a *App) popularTermsWidget() giu.Widget {
+	if !a.popularTermsLoaded {
+		a.loadPopularTerms()
+	}
+	if len(a.popularTerms) == 0 {
+		return giu.Row()
+	}
+
+	chips := make([]giu.Widget, 0, len(a.popularTerms)+1)
+	chips = append(chips, giu.Label("Popular searches:"))
+	for _, term := range a.popularTerms {
+		name := term.Name
+		chips = append(chips, giu.Button(name).OnClick(func() { a.runSearchFor(name) }))
+	}
+
+	return giu.Row(chips...)
+}
+
+// loadPopularTerms fetches the popular-searches sidebar once per session.
+// popularTermsLoaded is set regardless of outcome, so a failed fetch (e.g.
+// the markup changed) doesn't retry on every frame.
+func (a *App) loadPopularTerms() {
+	a.popularTermsLoaded = true
+
+	client := a.zlibClient()
+	if client == nil {
+		return
+	}
+
+	terms, err := client.GetPopularTerms(context.Background())
+	if err != nil {
+		return
+	}
+	a.popularTerms = terms
+}
+
+// runSearchFor runs a search for query, as if the user had typed it into
+// the search bar themselves - used by the popular-terms chips.
+func (a *App) runSearchFor(query string) {
+	a.query = query
+	a.runSearch()
+}
@@ -0,0 +1,46 @@
+package gui
+
+import "github.com/AllenDang/giu"
+
+// beginDownloadAs opens the inline "Download As..." path editor for
+// format, pre-filled with the path downloadFormat would use automatically.
+// There's no native save-file dialog wired into this UI yet (see
+// exportDetailsFile), so the picker is an editable text field instead.
+func (a *App) beginDownloadAs(format string) {
+	d := a.activeDetails
+	if d == nil {
+		return
+	}
+	a.downloadAsFormat = format
+	a.downloadAsPath = a.downloadDestPath(a.cfg.DownloadDir, d.Title, d.Author, d.ID, format)
+}
+
+// confirmDownloadAs starts the download to a.downloadAsPath, falling back
+// to the automatic path if it was cleared, then closes the picker.
+func (a *App) confirmDownloadAs() {
+	format, destPath := a.downloadAsFormat, a.downloadAsPath
+	a.downloadAsFormat = ""
+	a.downloadAsPath = ""
+	a.downloadFormatTo(format, destPath)
+}
+
+// cancelDownloadAs closes the picker without starting a download.
+func (a *App) cancelDownloadAs() {
+	a.downloadAsFormat = ""
+	a.downloadAsPath = ""
+}
+
+// downloadAsWidget renders the inline destination editor while the picker
+// is open for some format, and nothing otherwise.
+func (a *App) downloadAsWidget() giu.Widget {
+	if a.downloadAsFormat == "" {
+		return giu.Row()
+	}
+	return giu.Column(
+		giu.InputText(&a.downloadAsPath).Label("Save "+a.downloadAsFormat+" as"),
+		giu.Row(
+			giu.Button("Save").OnClick(a.confirmDownloadAs),
+			giu.Button("Cancel").OnClick(a.cancelDownloadAs),
+		),
+	)
+}
@@ -0,0 +1,76 @@
+package gui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AllenDang/giu"
+)
+
+// startOfToday returns midnight for the counter's configured "day"
+// boundary - UTC if cfg.DownloadCounterUTC is set, the local zone
+// otherwise - for CountSince to tally against.
+func (a *App) startOfToday() time.Time {
+	now := time.Now()
+	if a.cfg.DownloadCounterUTC {
+		now = now.UTC()
+	}
+	y, m, d := now.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+}
+
+// downloadsToday returns how many downloads RecordDownload has logged so
+// far today, or 0 if there's no catalog to ask.
+func (a *App) downloadsToday() int {
+	if a.catalog == nil {
+		return 0
+	}
+	n, err := a.catalog.CountSince(a.startOfToday())
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// downloadsTodayText renders the status bar's daily download counter.
+func (a *App) downloadsTodayText() string {
+	return fmt.Sprintf("%d download(s) today", a.downloadsToday())
+}
+
+// overDailySoftLimit reports whether today's download count has reached
+// the configured soft limit. A zero limit disables the check entirely.
+func (a *App) overDailySoftLimit() bool {
+	return a.cfg.DailyDownloadSoftLimit > 0 && a.downloadsToday() >= a.cfg.DailyDownloadSoftLimit
+}
+
+// downloadSoftLimitTooltip returns the warning to attach to a download
+// button once the soft limit has been reached, or "" below it.
+func (a *App) downloadSoftLimitTooltip() string {
+	if !a.overDailySoftLimit() {
+		return ""
+	}
+	return fmt.Sprintf("You've downloaded %d today, at or past your configured limit of %d.", a.downloadsToday(), a.cfg.DailyDownloadSoftLimit)
+}
+
+// recordDownloadCount logs one completed download against today's tally,
+// for downloadsToday to report later. Failures are non-fatal - the
+// counter is a pacing aid, not a feature anything else depends on.
+func (a *App) recordDownloadCount() {
+	if a.catalog != nil {
+		a.catalog.RecordDownload()
+	}
+}
+
+// openAfterDownloadIfEnabled opens destPath in the system's default
+// application when cfg.OpenAfterDownload is set. Only ever called from a
+// successful-download path - a failed or cancelled download never reaches
+// here, so there's nothing to gate on besides the setting itself.
+func (a *App) openAfterDownloadIfEnabled(destPath string) {
+	if !a.cfg.OpenAfterDownload {
+		return
+	}
+	if err := openWithSystemDefault(destPath); err != nil {
+		a.status = "Downloaded, but failed to open it: " + err.Error()
+		giu.Update()
+	}
+}
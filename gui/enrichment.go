@@ -0,0 +1,137 @@
+package gui
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/AllenDang/giu"
+)
+
+// enrichmentInterval paces the background enrichment worker's detail
+// fetches, the same role isbnLookupInterval plays for the ISBN import
+// loop - keeping a background feature's request volume well below what a
+// user clicking through results by hand would generate.
+const enrichmentInterval = 2 * time.Second
+
+// enrichmentConcurrency bounds how many detail fetches the worker may have
+// in flight at once. This table isn't virtualized (no scroll clipper), so
+// "visible" here means "loaded but not yet enriched" rather than a true
+// on-screen viewport - kept small since it's still speculative work for
+// results a user may never scroll to.
+const enrichmentConcurrency = 2
+
+// startEnrichmentWorker launches a background worker that fills in
+// Format/SizeText for the current result set's entries, one detail fetch
+// at a time per slot, pausing whenever a user-initiated detail fetch or
+// download is in flight. It's a no-op if lazy enrichment is disabled in
+// settings. Call stopEnrichmentWorker first if a previous run might still
+// be active (runSearch and loadMoreResults both do).
+func (a *App) startEnrichmentWorker() {
+	if !a.cfg.EnableLazyEnrichment {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.enrichmentCancel = cancel
+
+	indices := make(chan int)
+	go func() {
+		defer close(indices)
+		for i := range a.results {
+			select {
+			case indices <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for worker := 0; worker < enrichmentConcurrency; worker++ {
+		go a.runEnrichmentWorker(ctx, indices)
+	}
+}
+
+// stopEnrichmentWorker cancels the in-flight enrichment worker, if any.
+func (a *App) stopEnrichmentWorker() {
+	if a.enrichmentCancel != nil {
+		a.enrichmentCancel()
+	}
+}
+
+// runEnrichmentWorker drains indices, enriching one result at a time,
+// until ctx is cancelled or indices closes.
+func (a *App) runEnrichmentWorker(ctx context.Context, indices <-chan int) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case i, ok := <-indices:
+			if !ok {
+				return
+			}
+			a.waitForUserActivity(ctx)
+			a.enrichResultAt(ctx, i)
+
+			select {
+			case <-time.After(enrichmentInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// waitForUserActivity blocks while a user-initiated detail fetch or
+// download is running, so the enrichment worker never competes with them
+// for the same rate-limited connection. It returns early if ctx is done.
+func (a *App) waitForUserActivity(ctx context.Context) {
+	for atomic.LoadInt32(&a.userFetchActive) > 0 || atomic.LoadInt32(&a.activeDownloads) > 0 {
+		select {
+		case <-time.After(200 * time.Millisecond):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// enrichResultAt fetches details for a.results[i], if it's missing a
+// format or size and still has a stable DetailsURL to fetch by, and
+// updates the row and catalog in place.
+func (a *App) enrichResultAt(ctx context.Context, i int) {
+	if i < 0 || i >= len(a.results) {
+		return
+	}
+	r := a.results[i]
+	if r.Format != "" && r.SizeText != "" {
+		return
+	}
+
+	provider, ok := a.providerFor(r.Source)
+	if !ok {
+		return
+	}
+
+	details, err := provider.Details(ctx, r.DetailsURL)
+	if err != nil {
+		return
+	}
+
+	if i >= len(a.results) || a.results[i].DetailsURL != r.DetailsURL {
+		return // the result set changed under us; drop this stale fetch
+	}
+	for _, f := range details.OtherFormats {
+		if f.ConversionOnly {
+			continue
+		}
+		a.results[i].Format = f.Format
+		a.results[i].SizeText = f.SizeText
+		break
+	}
+	a.invalidateResultRowCache()
+	giu.Update()
+
+	if a.catalog != nil {
+		a.catalog.SaveSearchResult(a.results[i])
+	}
+}
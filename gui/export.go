@@ -0,0 +1,30 @@
+package gui
+
+import (
+	"bytes"
+
+	"github.com/atotto/clipboard"
+
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+// copyResultsMarkdown renders the current results list as a Markdown
+// table and copies it to the system clipboard, for pasting into notes or
+// sharing a reading list.
+func (a *App) copyResultsMarkdown() {
+	if len(a.results) == 0 {
+		a.status = "No results to export."
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := zlibrary.ExportMarkdown(a.results, &buf); err != nil {
+		a.status = "Failed to export results: " + err.Error()
+		return
+	}
+	if err := clipboard.WriteAll(buf.String()); err != nil {
+		a.status = "Failed to copy to clipboard: " + err.Error()
+		return
+	}
+	a.status = "Results copied as Markdown."
+}
@@ -0,0 +1,58 @@
+package gui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Advik-B/The-Blackbook-Archive/utils"
+)
+
+// sanitizeFilename strips characters that are invalid (or awkward) in file
+// names on common filesystems, so a scraped title can be used directly.
+func sanitizeFilename(name string) string {
+	replacer := strings.NewReplacer(
+		"/", "-", "\\", "-", ":", "-", "*", "", "?", "",
+		"\"", "", "<", "", ">", "", "|", "-",
+	)
+	return strings.TrimSpace(replacer.Replace(name))
+}
+
+// downloadFilenameStem builds the base name (no extension) a download of
+// title by author should use: "Title - Author" with the author normalized
+// via utils.NormalizeAuthor (so "DOE, JANE" and "jane doe" both file the
+// same way), or just "Title" when a.cfg.IncludeAuthorInFilenames is off or
+// author is empty.
+//
+// When a.cfg.TransliterateNonLatinTitles is on, title and author are
+// romanized via utils.Transliterate first, so a Cyrillic/CJK/Devanagari
+// title doesn't produce a filename some filesystems and sync tools
+// mangle; this only affects the filename - sidecar metadata and history
+// keep using the original, un-transliterated title and author. If
+// romanizing leaves nothing usable (e.g. a title entirely in Kanji, which
+// Transliterate can't romanize), bookID is sanitized and used instead.
+func (a *App) downloadFilenameStem(title, author, bookID string) string {
+	if a.cfg.TransliterateNonLatinTitles {
+		title, author = utils.Transliterate(title), utils.Transliterate(author)
+	}
+
+	stem := sanitizeFilename(title)
+	if a.cfg.IncludeAuthorInFilenames && author != "" {
+		if authors := utils.SplitAuthors(author); len(authors) > 0 {
+			stem += " - " + sanitizeFilename(authors[0])
+		}
+	}
+
+	if strings.TrimSpace(stem) == "" && bookID != "" {
+		return sanitizeFilename(bookID)
+	}
+	return stem
+}
+
+// downloadDestPath builds the local path a download of title by author
+// (identified by bookID, used only as a filename fallback) in format
+// should be saved to, creating dir if it doesn't exist yet.
+func (a *App) downloadDestPath(dir, title, author, bookID, format string) string {
+	os.MkdirAll(dir, 0o755)
+	return filepath.Join(dir, a.downloadFilenameStem(title, author, bookID)+"."+format)
+}
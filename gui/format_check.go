@@ -0,0 +1,26 @@
+package gui
+
+import (
+	"github.com/Advik-B/The-Blackbook-Archive/download"
+)
+
+// finishDownload records a just-completed download to destPath and sets
+// the status bar to successStatus, unless download.VerifyFormat finds that
+// the file's actual content doesn't match format - in which case it warns
+// instead and skips auto-open and the post-download hook, so a
+// mislabeled or substituted file doesn't get silently handed to another
+// program. The download count and lastDownloadPath are recorded either
+// way, since the file itself is still on disk and still worth tracking.
+func (a *App) finishDownload(destPath, format, title, author, successStatus string) {
+	a.lastDownloadPath = destPath
+	a.recordDownloadCount()
+
+	if err := download.VerifyFormat(destPath, format); err != nil {
+		a.status = err.Error() + " - keeping the file, but skipping auto-open and any post-download hook."
+		return
+	}
+
+	a.status = successStatus
+	a.openAfterDownloadIfEnabled(destPath)
+	a.runPostDownloadHook(destPath, title, author, format)
+}
@@ -0,0 +1,362 @@
+package gui
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/AllenDang/giu"
+
+	"github.com/Advik-B/The-Blackbook-Archive/download"
+	"github.com/Advik-B/The-Blackbook-Archive/utils"
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+// isbnLookupInterval spaces out SearchByISBN calls during a batch import,
+// the same way the rest of the app waits out Z-Library's own rate limit
+// rather than hammering it with one request per row.
+const isbnLookupInterval = 2 * time.Second
+
+// isbnImportStatus is where one row of a batch ISBN import currently
+// stands.
+type isbnImportStatus string
+
+const (
+	isbnPending   isbnImportStatus = "pending"
+	isbnInvalid   isbnImportStatus = "invalid"
+	isbnNotFound  isbnImportStatus = "not found"
+	isbnFound     isbnImportStatus = "found"
+	isbnAmbiguous isbnImportStatus = "ambiguous"
+)
+
+// isbnImportRow is one line of an imported ISBN list, plus whatever the
+// lookup loop found for it.
+type isbnImportRow struct {
+	ISBN   string
+	Status isbnImportStatus
+	Title  string
+	Format string
+	Match  zlibrary.BookSearchResult
+}
+
+// isbnImportWidget renders the "Import ISBN list..." action and, once a
+// list has been imported, the review table it builds.
+func (a *App) isbnImportWidget() giu.Widget {
+	return giu.Column(
+		giu.Row(
+			giu.InputText(&a.isbnImportPath).Size(400).Label("ISBN list file (.txt/.csv)"),
+			giu.Button("Import ISBN list...").OnClick(func() { a.importISBNList(a.isbnImportPath) }),
+			giu.Custom(func() {
+				if atomic.LoadInt32(&a.isbnImportRunning) == 0 {
+					return
+				}
+				giu.Button("Cancel").OnClick(a.cancelISBNImport).Build()
+			}),
+		),
+		a.isbnImportTableWidget(),
+	)
+}
+
+func (a *App) isbnImportTableWidget() giu.Widget {
+	return giu.Custom(func() {
+		if len(a.isbnImportRows) == 0 {
+			return
+		}
+
+		rows := make([]*giu.TableRowWidget, 0, len(a.isbnImportRows))
+		for i := range a.isbnImportRows {
+			idx := i
+			row := a.isbnImportRows[idx]
+			selected := a.isbnImportSelected[idx]
+			rows = append(rows, giu.TableRow(
+				giu.Checkbox(fmt.Sprintf("##isbn-select-%d", idx), &selected).OnChange(func() {
+					a.toggleISBNRowSelected(idx, selected)
+				}),
+				giu.Label(row.ISBN),
+				giu.Label(string(row.Status)),
+				giu.Label(row.Title),
+				giu.Label(row.Format),
+			))
+		}
+
+		giu.Column(
+			giu.Table().Rows(rows...),
+			giu.Row(
+				giu.Button("Download selected").OnClick(a.downloadSelectedISBNRows),
+				giu.Button("Export report as CSV").OnClick(a.exportISBNReportToConfiguredPath),
+			),
+		).Build()
+	})
+}
+
+func (a *App) toggleISBNRowSelected(idx int, selected bool) {
+	if a.isbnImportSelected == nil {
+		a.isbnImportSelected = make(map[int]bool)
+	}
+	if selected {
+		a.isbnImportSelected[idx] = true
+		return
+	}
+	delete(a.isbnImportSelected, idx)
+}
+
+// importISBNList reads path (one ISBN per line, or a CSV with a column
+// named "isbn"), validates each entry, and appends any not already in
+// a.isbnImportRows before kicking off the lookup loop - so re-importing
+// the same file after a partial run resumes rather than re-queuing rows
+// that already resolved.
+func (a *App) importISBNList(path string) {
+	isbns, err := readISBNList(path)
+	if err != nil {
+		a.status = "Failed to read ISBN list: " + err.Error()
+		return
+	}
+
+	seen := make(map[string]bool, len(a.isbnImportRows))
+	for _, r := range a.isbnImportRows {
+		seen[r.ISBN] = true
+	}
+	for _, isbn := range isbns {
+		if seen[isbn] {
+			continue
+		}
+		seen[isbn] = true
+
+		status := isbnPending
+		if !utils.IsValidISBN(isbn) {
+			status = isbnInvalid
+		}
+		a.isbnImportRows = append(a.isbnImportRows, isbnImportRow{ISBN: isbn, Status: status})
+	}
+
+	a.status = fmt.Sprintf("Imported %d ISBN(s), looking them up...", len(isbns))
+	a.runISBNLookups()
+}
+
+// readISBNList parses a plain-text (one ISBN per line) or CSV (with an
+// "isbn" column) file into a slice of raw ISBN strings.
+func readISBNList(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var isbns []string
+	isbnColumn := -1
+	firstLine := true
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ",")
+		if firstLine {
+			firstLine = false
+			for i, field := range fields {
+				if strings.EqualFold(strings.TrimSpace(field), "isbn") {
+					isbnColumn = i
+					break
+				}
+			}
+			if isbnColumn >= 0 {
+				continue // header row, not data
+			}
+		}
+
+		candidate := fields[0]
+		if isbnColumn >= 0 && isbnColumn < len(fields) {
+			candidate = fields[isbnColumn]
+		}
+		isbns = append(isbns, strings.TrimSpace(candidate))
+	}
+	return isbns, scanner.Err()
+}
+
+// runISBNLookups resolves every pending row in a.isbnImportRows against
+// SearchByISBN, one at a time with isbnLookupInterval between requests,
+// skipping rows a previous run already resolved. It's a no-op if a run is
+// already in flight.
+func (a *App) runISBNLookups() {
+	if !atomic.CompareAndSwapInt32(&a.isbnImportRunning, 0, 1) {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.isbnImportCancel = cancel
+
+	go func() {
+		defer func() {
+			atomic.StoreInt32(&a.isbnImportRunning, 0)
+			giu.Update()
+		}()
+
+		client := a.zlibClient()
+		if client == nil {
+			a.status = "ISBN lookup is only available for Z-Library."
+			return
+		}
+
+		for i := range a.isbnImportRows {
+			if ctx.Err() != nil {
+				return
+			}
+
+			row := &a.isbnImportRows[i]
+			if row.Status != isbnPending {
+				continue
+			}
+
+			results, err := client.SearchByISBN(ctx, row.ISBN)
+			switch {
+			case err != nil || len(results) == 0:
+				row.Status = isbnNotFound
+			case len(results) == 1:
+				row.Status = isbnFound
+				row.Title = results[0].Title
+				row.Format = results[0].Format
+				row.Match = results[0]
+			default:
+				row.Status = isbnAmbiguous
+				row.Title = results[0].Title
+				row.Format = results[0].Format
+				row.Match = results[0]
+			}
+			giu.Update()
+
+			select {
+			case <-time.After(isbnLookupInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// cancelISBNImport stops the in-flight lookup loop, if any. Rows already
+// resolved stay resolved; runISBNLookups picks up where it left off if
+// started again.
+func (a *App) cancelISBNImport() {
+	if a.isbnImportCancel != nil {
+		a.isbnImportCancel()
+	}
+}
+
+// downloadSelectedISBNRows enqueues a download for every checked row in
+// the review table that resolved to a match.
+func (a *App) downloadSelectedISBNRows() {
+	if len(a.isbnImportSelected) == 0 {
+		a.status = "No ISBN rows selected."
+		return
+	}
+
+	if a.overDailySoftLimit() && !a.pendingBulkDownloadConfirm {
+		a.pendingBulkDownloadConfirm = true
+		a.status = fmt.Sprintf("You've already downloaded %d today. Click \"Download selected\" again to confirm.", a.downloadsToday())
+		return
+	}
+	a.pendingBulkDownloadConfirm = false
+
+	selected := make([]int, 0, len(a.isbnImportSelected))
+	for idx := range a.isbnImportSelected {
+		selected = append(selected, idx)
+	}
+
+	a.status = "Downloading selected ISBN matches..."
+	go a.downloadISBNRows(selected)
+}
+
+// downloadISBNRows fetches full details and downloads the primary format
+// for each selected row, skipping anything that errors and continuing
+// with the rest.
+func (a *App) downloadISBNRows(indexes []int) {
+	provider, ok := a.providers[zlibrary.SourceName]
+	if !ok {
+		a.status = "ISBN downloads are only available for Z-Library."
+		return
+	}
+
+	for _, idx := range indexes {
+		if idx < 0 || idx >= len(a.isbnImportRows) {
+			continue
+		}
+		row := a.isbnImportRows[idx]
+		if row.Status != isbnFound && row.Status != isbnAmbiguous {
+			continue
+		}
+
+		details, err := provider.Details(context.Background(), row.Match.DetailsURL)
+		if err != nil {
+			a.status = "ISBN import: failed to load " + row.ISBN + ": " + err.Error()
+			continue
+		}
+
+		req, err := provider.DownloadRequest(context.Background(), details, details.Format)
+		if err != nil {
+			a.status = "ISBN import: download failed for " + row.ISBN + ": " + err.Error()
+			continue
+		}
+
+		destPath := a.downloadDestPath(a.cfg.DownloadDir, details.Title, details.Author, details.ID, details.Format)
+
+		atomic.AddInt32(&a.activeDownloads, 1)
+		err = a.downloader.Download(req, destPath)
+		atomic.AddInt32(&a.activeDownloads, -1)
+		if err != nil {
+			a.status = "ISBN import: download failed for " + row.ISBN + ": " + err.Error()
+			continue
+		}
+		a.lastDownloadPath = destPath
+		a.recordDownloadCount()
+		if err := download.VerifyFormat(destPath, details.Format); err != nil {
+			a.status = "ISBN import: " + row.ISBN + ": " + err.Error()
+		}
+		giu.Update()
+	}
+
+	a.status = "ISBN import downloads finished."
+	giu.Update()
+}
+
+// exportISBNReportToConfiguredPath writes the review table as a CSV report
+// into the configured download directory.
+func (a *App) exportISBNReportToConfiguredPath() {
+	os.MkdirAll(a.cfg.DownloadDir, 0o755)
+	path := filepath.Join(a.cfg.DownloadDir, "isbn-import-report.csv")
+
+	if err := a.exportISBNReportCSV(path); err != nil {
+		a.status = "Failed to export ISBN report: " + err.Error()
+		return
+	}
+	a.status = "ISBN report exported to " + path
+}
+
+// exportISBNReportCSV writes the review table as CSV to path.
+func (a *App) exportISBNReportCSV(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	if err := w.Write([]string{"isbn", "status", "title", "format"}); err != nil {
+		return err
+	}
+	for _, row := range a.isbnImportRows {
+		if err := w.Write([]string{row.ISBN, string(row.Status), row.Title, row.Format}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,28 @@
+package gui
+
+import (
+	"fmt"
+	"os"
+)
+
+// confirmOverwrite reports whether a download to destPath is clear to
+// start. If destPath doesn't exist yet, or cfg.SkipOverwriteConfirm is set,
+// it always is. Otherwise the first call for a given destPath sets a
+// status message and returns false, so the caller can bail out; clicking
+// the same download action again for the same destPath (pendingOverwriteConfirm
+// still matching) is treated as confirmation and clears the pending state.
+func (a *App) confirmOverwrite(destPath string) bool {
+	if a.cfg.SkipOverwriteConfirm {
+		return true
+	}
+	if _, err := os.Stat(destPath); err != nil {
+		return true
+	}
+	if a.pendingOverwriteConfirm == destPath {
+		a.pendingOverwriteConfirm = ""
+		return true
+	}
+	a.pendingOverwriteConfirm = destPath
+	a.status = fmt.Sprintf("%s already exists. Click download again to overwrite it.", destPath)
+	return false
+}
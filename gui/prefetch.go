@@ -0,0 +1,73 @@
+package gui
+
+import (
+	"context"
+
+	"github.com/Advik-B/The-Blackbook-Archive/prefetch"
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+// coverPrefetchCount is how many of a fresh result set's covers get queued
+// immediately, rather than waiting for thumbnailWidget to request them as
+// rows scroll into view.
+const coverPrefetchCount = 12
+
+// coverPrefetchWorkers is the prefetch pool's worker count - deliberately
+// small so it doesn't compete with user-driven thumbnail fetches or the
+// rate limiter for the same connection.
+const coverPrefetchWorkers = 3
+
+// startCoverPrefetch cancels any prefetch still running for a previous
+// result set and queues the first coverPrefetchCount covers of results
+// into a small worker pool, so they're likely already cached by the time
+// the user scrolls to them. It never blocks the caller - results are
+// already on screen by the time this runs.
+func (a *App) startCoverPrefetch(results []zlibrary.BookSearchResult) {
+	a.stopCoverPrefetch()
+
+	n := len(results)
+	if n > coverPrefetchCount {
+		n = coverPrefetchCount
+	}
+
+	urls := make([]string, 0, n)
+	for _, r := range results[:n] {
+		if r.CoverURL != "" {
+			urls = append(urls, r.CoverURL)
+		}
+	}
+	if len(urls) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.prefetchCancel = cancel
+
+	go prefetch.Run(ctx, urls, coverPrefetchWorkers, a.prefetchCover)
+}
+
+// stopCoverPrefetch cancels a running cover prefetch, if any.
+func (a *App) stopCoverPrefetch() {
+	if a.prefetchCancel != nil {
+		a.prefetchCancel()
+		a.prefetchCancel = nil
+	}
+}
+
+// prefetchCover fetches coverURL into the thumbnail cache unless it's
+// already cached, failed, or already being fetched by something else -
+// the same gate thumbnailWidget uses before kicking off a fetch of its own.
+func (a *App) prefetchCover(coverURL string) {
+	a.thumbnails.mu.Lock()
+	_, cached := a.thumbnails.textures[coverURL]
+	failed := a.thumbnails.fetchFailed[coverURL] || a.thumbnails.textureFailed[coverURL]
+	loading := a.thumbnails.loading[coverURL]
+	if cached || failed || loading {
+		a.thumbnails.mu.Unlock()
+		return
+	}
+	a.thumbnails.loading[coverURL] = true
+	a.thumbnails.mu.Unlock()
+
+	a.fetchThumbnail(coverURL)
+}
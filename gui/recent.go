@@ -0,0 +1,52 @@
+package gui
+
+import "github.com/AllenDang/giu"
+
+// recentViewsWidget renders a collapsible list of recently-viewed books,
+// newest first, letting a user jump straight back into a book's details
+// without re-running the search that found it. The list is refreshed from
+// the catalog each time the section is expanded, so a view recorded in
+// another tab of the same process shows up without a restart.
+func (a *App) recentViewsWidget() giu.Widget {
+	return giu.Column(
+		giu.Checkbox("Recent", &a.showRecent).OnChange(func() {
+			if a.showRecent {
+				a.loadRecentViews()
+			}
+		}),
+		giu.Custom(func() {
+			if !a.showRecent {
+				return
+			}
+			if len(a.recentViews) == 0 {
+				giu.Label("No recently viewed books yet.").Build()
+				return
+			}
+
+			rows := make([]*giu.TableRowWidget, 0, len(a.recentViews))
+			for i := range a.recentViews {
+				r := a.recentViews[i]
+				rows = append(rows, giu.TableRow(
+					giu.Button(r.Title).OnClick(func() { a.openDetails(r) }),
+					giu.Label(r.Author),
+					giu.Label(r.Source),
+				))
+			}
+			giu.Table().Rows(rows...).Build()
+		}),
+	)
+}
+
+// loadRecentViews refreshes a.recentViews from the catalog's persisted
+// recently-viewed list.
+func (a *App) loadRecentViews() {
+	if a.catalog == nil {
+		return
+	}
+	recent, err := a.catalog.RecentViews()
+	if err != nil {
+		a.status = "Failed to load recent books: " + err.Error()
+		return
+	}
+	a.recentViews = recent
+}
@@ -0,0 +1,37 @@
+package gui
+
+import (
+	"github.com/AllenDang/giu"
+
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+// unavailableResultWidget renders a one-off notice offering to drop the
+// last openDetails failure's result from the recent-views list, shown after
+// a stale link 404s or turns out to be a removed-notice page instead of
+// silently leaving the user at the unchanged details pane.
+func (a *App) unavailableResultWidget() giu.Widget {
+	if a.unavailableResult == nil {
+		return giu.Row()
+	}
+	r := *a.unavailableResult
+	return giu.Row(
+		giu.Label("\""+r.Title+"\" is no longer available."),
+		giu.Button("Remove from recent").OnClick(func() { a.removeUnavailableResult(r) }),
+		giu.Button("Dismiss").OnClick(func() { a.unavailableResult = nil }),
+	)
+}
+
+// removeUnavailableResult drops r from the persisted recent-views list and
+// clears the notice, for the "Remove from recent" action.
+func (a *App) removeUnavailableResult(r zlibrary.BookSearchResult) {
+	if a.catalog != nil && r.ID != "" {
+		if err := a.catalog.RemoveRecentView(r.ID); err != nil {
+			a.status = "Failed to remove: " + err.Error()
+			return
+		}
+		a.loadRecentViews()
+	}
+	a.unavailableResult = nil
+	a.status = "Removed from recent."
+}
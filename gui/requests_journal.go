@@ -0,0 +1,65 @@
+package gui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AllenDang/giu"
+
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+// requestJournalPanelRows caps how many of the journal's most recent
+// entries are actually rendered, so a long session with a full journal
+// doesn't lay out hundreds of rows every frame. The journal itself already
+// bounds how much it retains; see zlibrary.RequestJournal.
+const requestJournalPanelRows = 50
+
+// requestJournalToggleWidget is the button that opens or closes the
+// requests panel, next to the in-flight badge so it's visible regardless
+// of which other panel (details, settings, ...) is currently open.
+func (a *App) requestJournalToggleWidget() giu.Widget {
+	if a.journal == nil {
+		return giu.Row()
+	}
+	label := "Show requests"
+	if a.showJournal {
+		label = "Hide requests"
+	}
+	return giu.Row(giu.Button(label).OnClick(func() { a.showJournal = !a.showJournal }))
+}
+
+// requestJournalWidget renders the session's recent Z-Library request
+// journal, most recent first, while the panel is open - for debugging a
+// stuck or failing search without turning on SetHTTPDump's full body
+// capture.
+func (a *App) requestJournalWidget() giu.Widget {
+	if !a.showJournal || a.journal == nil {
+		return giu.Row()
+	}
+
+	entries := a.journal.Entries()
+	if len(entries) == 0 {
+		return giu.Column(giu.Label("No requests recorded yet."))
+	}
+
+	rows := make([]giu.Widget, 0, requestJournalPanelRows)
+	for i := len(entries) - 1; i >= 0 && len(rows) < requestJournalPanelRows; i-- {
+		rows = append(rows, giu.Label(journalEntryText(entries[i])))
+	}
+
+	return giu.Column(append(
+		[]giu.Widget{giu.Row(giu.Button("Clear").OnClick(func() { a.journal.Clear() }))},
+		rows...,
+	)...)
+}
+
+// journalEntryText formats a single journal entry as one status-bar-style
+// line: its method, URL, outcome, and latency.
+func journalEntryText(e zlibrary.JournalEntry) string {
+	outcome := fmt.Sprintf("%d", e.Status)
+	if e.Err != "" {
+		outcome = "error: " + e.Err
+	}
+	return fmt.Sprintf("%s %s - %s (%s)", e.Method, e.URL, outcome, e.Duration.Round(time.Millisecond))
+}
@@ -0,0 +1,126 @@
+package gui
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AllenDang/giu"
+
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+// resultRowCache holds previously built TableRowWidgets for the results
+// list, keyed by a row's position, so a frame that changes nothing about a
+// row (no new thumbnail texture, no selection change) can reuse the widget
+// it already built instead of re-walking every cell. The widgets themselves
+// are cheap, but the map lookups this replaces - one per row, per
+// fetchThumbnail check - otherwise happen on every single frame regardless
+// of whether anything changed.
+type resultRowCache struct {
+	rows map[int]*cachedRow
+}
+
+type cachedRow struct {
+	key    rowCacheKey
+	widget *giu.TableRowWidget
+}
+
+// rowCacheKey identifies what a cached row depends on: which result it
+// renders, which texture its thumbnail currently shows (nil if still
+// loading/missing), whether that thumbnail has failed, whether it's
+// selected, and whether compare mode is on. Any change to these, and only
+// these, invalidates the cache entry.
+type rowCacheKey struct {
+	detailsURL  string
+	texture     *giu.Texture
+	thumbFailed bool
+	selected    bool
+	compare     bool
+}
+
+func newResultRowCache() *resultRowCache {
+	return &resultRowCache{rows: make(map[int]*cachedRow)}
+}
+
+// invalidateResultRowCache drops every cached row, for whenever the
+// underlying result set itself changes (a fresh search, a page append, a
+// hide/unhide) rather than just a texture or selection flipping.
+func (a *App) invalidateResultRowCache() {
+	a.resultRows = newResultRowCache()
+}
+
+// buildResultRow returns row r's TableRowWidget, reusing the previous
+// frame's widget when nothing r's key depends on has changed.
+func (a *App) buildResultRow(i int, r zlibrary.BookSearchResult) *giu.TableRowWidget {
+	a.thumbnails.mu.Lock()
+	texture := a.thumbnails.textures[r.CoverURL]
+	thumbFailed := a.thumbnails.fetchFailed[r.CoverURL] || a.thumbnails.textureFailed[r.CoverURL]
+	a.thumbnails.mu.Unlock()
+	_, selected := a.selected[r.DetailsURL]
+	key := rowCacheKey{detailsURL: r.DetailsURL, texture: texture, thumbFailed: thumbFailed, selected: selected, compare: a.compareMode}
+
+	if cached, ok := a.resultRows.rows[i]; ok && cached.key == key {
+		return cached.widget
+	}
+
+	cells := []giu.Widget{
+		a.thumbnailWidget(r.CoverURL),
+		giu.Row(
+			giu.Button(r.Title).OnClick(func() { a.openDetails(r) }),
+			a.resultContextMenuWidget(r),
+		),
+		giu.Label(r.Author),
+		giu.Label(r.Year),
+		giu.Label(r.Format),
+		giu.Label(r.Source),
+	}
+	if a.compareMode {
+		checked := selected
+		cells = append([]giu.Widget{
+			giu.Checkbox(fmt.Sprintf("##select-%d", i), &checked).OnChange(func() {
+				a.toggleSelected(r, checked)
+			}),
+		}, cells...)
+	}
+
+	row := giu.TableRow(cells...)
+	a.resultRows.rows[i] = &cachedRow{key: key, widget: row}
+	return row
+}
+
+// loadMoreResults fetches the next page of results for the active query and
+// appends it to a.results, rather than replacing it, so "Load more" grows
+// the list the way paging through search results normally would.
+func (a *App) loadMoreResults() {
+	provider, ok := a.providers[a.activeProvider]
+	if !ok {
+		a.status = "No source selected."
+		return
+	}
+
+	nextPage := a.lastSearchPage + 1
+	results, err := provider.Search(context.Background(), a.query, nextPage)
+	if err != nil {
+		a.status = "Failed to load more results: " + err.Error()
+		return
+	}
+	if len(results) == 0 {
+		a.status = "No more results."
+		return
+	}
+
+	a.results = append(a.results, results...)
+	a.lastSearchPage = nextPage
+	a.invalidateResultRowCache()
+	a.status = "Ready."
+
+	if a.catalog != nil {
+		for _, r := range results {
+			a.catalog.SaveSearchResult(r)
+		}
+	}
+
+	a.stopEnrichmentWorker()
+	a.startEnrichmentWorker()
+	a.startCoverPrefetch(results)
+}
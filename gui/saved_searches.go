@@ -0,0 +1,97 @@
+package gui
+
+import (
+	"context"
+	"time"
+
+	"github.com/AllenDang/giu"
+)
+
+const savedSearchPollInterval = 10 * time.Minute
+
+// startSavedSearchPolling periodically re-checks every saved search in the
+// background for as long as the process runs.
+func (a *App) startSavedSearchPolling() {
+	go func() {
+		ticker := time.NewTicker(savedSearchPollInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			a.checkSavedSearches()
+			giu.Update()
+		}
+	}()
+}
+
+// SavedSearch is a query the user wants to keep re-running in the
+// background, so new matches can surface as a notification instead of
+// requiring a manual re-search.
+type SavedSearch struct {
+	Query       string
+	Source      string
+	SeenResults map[string]bool
+}
+
+// checkSavedSearches re-runs every saved search and reports how many
+// results are new since the last check, without disturbing the main
+// results list the user is currently looking at.
+func (a *App) checkSavedSearches() {
+	for i := range a.savedSearches {
+		s := &a.savedSearches[i]
+
+		provider, ok := a.providers[s.Source]
+		if !ok {
+			continue
+		}
+
+		results, err := provider.Search(context.Background(), s.Query, 1)
+		if err != nil {
+			continue
+		}
+
+		var newCount int
+		if s.SeenResults == nil {
+			s.SeenResults = make(map[string]bool)
+		}
+		for _, r := range results {
+			if !s.SeenResults[r.DetailsURL] {
+				s.SeenResults[r.DetailsURL] = true
+				newCount++
+			}
+		}
+
+		if newCount > 0 {
+			a.status = "New results for saved search \"" + s.Query + "\""
+		}
+	}
+}
+
+// saveCurrentSearch stores the current query as a saved search, seeding
+// its seen set with the results already on screen so only genuinely new
+// matches trigger a notification later.
+func (a *App) saveCurrentSearch() {
+	seen := make(map[string]bool, len(a.results))
+	for _, r := range a.results {
+		seen[r.DetailsURL] = true
+	}
+	a.savedSearches = append(a.savedSearches, SavedSearch{
+		Query:       a.query,
+		Source:      a.activeProvider,
+		SeenResults: seen,
+	})
+}
+
+func (a *App) savedSearchesWidget() giu.Widget {
+	if len(a.savedSearches) == 0 {
+		return giu.Row()
+	}
+
+	rows := make([]*giu.TableRowWidget, 0, len(a.savedSearches))
+	for _, s := range a.savedSearches {
+		rows = append(rows, giu.TableRow(giu.Label(s.Query), giu.Label(s.Source)))
+	}
+
+	return giu.Column(
+		giu.Button("Save current search").OnClick(a.saveCurrentSearch),
+		giu.Table().Rows(rows...),
+	)
+}
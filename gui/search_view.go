@@ -0,0 +1,119 @@
+package gui
+
+import (
+	"fmt"
+
+	"github.com/AllenDang/giu"
+
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+// searchBarWidget renders the search field and its surrounding controls.
+// focusSearchBox (toggled by the Ctrl+L/Ctrl+F shortcut - see Loop) makes
+// it call SetKeyboardFocusHere just before building the InputText, so the
+// field gains focus for exactly the one frame that asked for it rather
+// than stealing focus back every frame after.
+func (a *App) searchBarWidget() giu.Widget {
+	return giu.Row(
+		giu.Custom(func() {
+			if a.focusSearchBox {
+				giu.SetKeyboardFocusHere()
+				a.focusSearchBox = false
+			}
+		}),
+		giu.InputText(&a.query).Size(400).Flags(giu.InputTextFlagsAutoSelectAll).OnChange(func() {}),
+		giu.Combo("Source", a.activeProvider, a.providerNames, &a.activeProviderIdx).OnChange(func() {
+			a.activeProvider = a.providerNames[a.activeProviderIdx]
+		}),
+		giu.Checkbox("My library", &a.librarySearch),
+		giu.Button("Search").OnClick(a.runSearch),
+		giu.Button("My saved books").OnClick(a.openSavedBooks),
+		giu.Button("Copy as Markdown").OnClick(a.copyResultsMarkdown),
+		giu.Checkbox("Compare mode", &a.compareMode).OnChange(func() {
+			if !a.compareMode {
+				a.selected = make(map[string]zlibrary.BookSearchResult)
+			}
+		}),
+		giu.Checkbox("Group editions", &a.groupEditions),
+	)
+}
+
+// openURLWidget lets a user paste a book's URL directly (e.g. shared from
+// a browser or another device) and jump straight to its details, bypassing
+// search entirely.
+func (a *App) openURLWidget() giu.Widget {
+	return giu.Row(
+		giu.InputText(&a.bookURLInput).Size(400).Label("Open book URL"),
+		giu.Button("Open").OnClick(a.openDetailsFromURL),
+	)
+}
+
+// suggestionWidget renders the site's "did you mean" rewrite suggestion,
+// when the last search returned one, as a clickable row that re-runs the
+// search with the suggested query.
+func (a *App) suggestionWidget() giu.Widget {
+	if a.suggestion == nil {
+		return giu.Row()
+	}
+	suggestion := *a.suggestion
+	return giu.Row(
+		giu.Label("Did you mean:"),
+		giu.Button(suggestion).OnClick(func() { a.runSearchFor(suggestion) }),
+	)
+}
+
+func (a *App) resultsListWidget() giu.Widget {
+	if len(a.results) == 0 {
+		return giu.Column(a.suggestionWidget(), a.popularTermsWidget())
+	}
+	if a.groupEditions {
+		return a.groupedResultsWidget()
+	}
+
+	visible := a.visibleResults()
+	rows := make([]*giu.TableRowWidget, 0, len(visible)+1)
+	for i := range visible {
+		rows = append(rows, a.buildResultRow(i, visible[i]))
+	}
+
+	return giu.Column(
+		giu.Table().Rows(rows...),
+		giu.Button("Load more").OnClick(a.loadMoreResults),
+	)
+}
+
+// groupedResultsWidget renders results as one row per edition group, with
+// the edition count shown instead of a single row per near-duplicate.
+func (a *App) groupedResultsWidget() giu.Widget {
+	groups := zlibrary.GroupEditions(a.results)
+
+	rows := make([]*giu.TableRowWidget, 0, len(groups))
+	for _, g := range groups {
+		first := g.PreferredEdition(a.cfg.PreferSmallerEditions)
+		rows = append(rows, giu.TableRow(
+			giu.Row(
+				giu.Button(first.Title).OnClick(func() { a.openDetails(first) }),
+				a.resultContextMenuWidget(first),
+			),
+			giu.Label(first.Author),
+			giu.Label(fmt.Sprintf("%d edition(s)", len(g.Editions))),
+		))
+	}
+
+	return giu.Table().Rows(rows...)
+}
+
+// toggleSelected records or clears a book's membership in the comparison
+// set, refusing new selections past maxCompareSelection to keep the
+// comparison panel readable.
+func (a *App) toggleSelected(result zlibrary.BookSearchResult, checked bool) {
+	if checked {
+		if len(a.selected) >= maxCompareSelection {
+			a.status = fmt.Sprintf("You can compare at most %d books at a time.", maxCompareSelection)
+			return
+		}
+		a.selected[result.DetailsURL] = result
+		return
+	}
+	delete(a.selected, result.DetailsURL)
+}
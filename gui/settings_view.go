@@ -0,0 +1,206 @@
+package gui
+
+import (
+	"strings"
+
+	"github.com/AllenDang/giu"
+
+	"github.com/Advik-B/The-Blackbook-Archive/config"
+	"github.com/Advik-B/The-Blackbook-Archive/source"
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+// settingsWidget renders a collapsible panel for the settings that don't
+// warrant their own dedicated dialog, applying each change live in
+// addition to persisting it to disk.
+func (a *App) settingsWidget() giu.Widget {
+	return giu.Column(
+		giu.Checkbox("Settings", &a.showSettings),
+		giu.Custom(func() {
+			if !a.showSettings {
+				return
+			}
+			giu.Layout{
+				giu.Checkbox("Rotate User-Agent per session", &a.cfg.RotateUserAgent).OnChange(a.applyUserAgentSettings),
+				giu.InputText(&a.cfg.UserAgent).Label("Custom User-Agent (ignored while rotation is on)").OnChange(a.applyUserAgentSettings),
+				giu.Checkbox("Debug mode (save failed pages for inspection)", &a.cfg.DebugMode).OnChange(a.applyDebugSetting),
+				giu.Checkbox("Log every request/response for bug reports", &a.cfg.HTTPDump).OnChange(a.applyHTTPDumpSetting),
+				giu.Checkbox("Safe mode (strip tracking params from URLs)", &a.cfg.SafeMode).OnChange(a.applySafeModeSetting),
+				giu.InputText(&a.ipfsGatewaysInput).Label("IPFS gateways (comma-separated, blank for default)").OnChange(a.applyIPFSGatewaySettings),
+				giu.InputText(&a.preferredFormatsInput).Label("Preferred download formats (comma-separated, most preferred first)").OnChange(a.applyPreferredFormatsSetting),
+				giu.InputText(&a.mirrorInput).Label("Z-Library mirror URL (blank for default)"),
+				giu.Button("Use this mirror").OnClick(a.applyMirrorSetting),
+				giu.Button("Find working mirror").OnClick(func() { go a.discoverMirrors() }),
+				giu.Checkbox("Look for a working mirror automatically if the current one is unreachable", &a.cfg.AutoDiscoverMirrors),
+				giu.Custom(func() {
+					if len(a.cfg.DiscoveredMirrors) == 0 {
+						return
+					}
+					giu.Label("Last discovered (" + a.cfg.DiscoveredMirrorsAt.Format("2006-01-02 15:04") + "): " + strings.Join(a.cfg.DiscoveredMirrors, ", ")).Build()
+				}),
+				giu.Checkbox("Race the primary mirror against a candidate when searching", &a.cfg.EnableMirrorRacing).OnChange(a.applyMirrorRacingSettings),
+				giu.InputText(&a.mirrorCandidatesInput).Label("Mirror racing candidates (comma-separated, first one is used)").OnChange(a.applyMirrorRacingSettings),
+				giu.InputText(&a.cfg.Language).Label("Interface language (blank for OS default, e.g. ru, hi)").OnChange(a.applyLanguageSetting),
+				giu.Checkbox("Fetch format/size for loaded results in the background", &a.cfg.EnableLazyEnrichment).OnChange(a.applyLazyEnrichmentSetting),
+				giu.Checkbox("Use decimal (SI) byte units", &a.cfg.ByteFormatDecimal),
+				giu.Checkbox("Use IEC byte suffixes (MiB instead of MB)", &a.cfg.ByteFormatIEC),
+				giu.Checkbox("Open downloaded files automatically", &a.cfg.OpenAfterDownload),
+				giu.Checkbox("Include author in downloaded filenames", &a.cfg.IncludeAuthorInFilenames),
+				giu.Checkbox("Always overwrite existing files without asking", &a.cfg.SkipOverwriteConfirm),
+				giu.Checkbox("Prefer the smallest file size among matching editions", &a.cfg.PreferSmallerEditions),
+				giu.Checkbox("Romanize non-Latin titles in downloaded filenames", &a.cfg.TransliterateNonLatinTitles),
+				giu.Checkbox("Run a command after each download", &a.cfg.PostDownloadHookEnabled),
+				giu.InputText(&a.cfg.PostDownloadHookCommand).Label("Command (supports {path}, {title}, {author}, {format})"),
+				giu.Button("Save settings").OnClick(func() {
+					if err := a.cfg.Save(); err != nil {
+						a.status = "Failed to save settings: " + err.Error()
+						return
+					}
+					a.status = "Settings saved."
+				}),
+			}.Build()
+		}),
+	)
+}
+
+func (a *App) zlibClient() *zlibrary.Client {
+	p, ok := a.providers[zlibrary.SourceName]
+	if !ok {
+		return nil
+	}
+	zs, ok := p.(*source.ZLibrarySource)
+	if !ok {
+		return nil
+	}
+	return zs.Client
+}
+
+func (a *App) applyUserAgentSettings() {
+	client := a.zlibClient()
+	if client == nil {
+		return
+	}
+	switch {
+	case a.cfg.RotateUserAgent:
+		client.EnableUserAgentRotation()
+	case a.cfg.UserAgent != "":
+		client.SetUserAgent(a.cfg.UserAgent)
+	}
+}
+
+func (a *App) applySafeModeSetting() {
+	client := a.zlibClient()
+	if client == nil {
+		return
+	}
+	client.SetSafeMode(a.cfg.SafeMode)
+}
+
+// applyIPFSGatewaySettings parses a.ipfsGatewaysInput into cfg.IPFSGateways
+// and pushes it into the concrete Z-Library client.
+func (a *App) applyIPFSGatewaySettings() {
+	var gateways []string
+	for _, g := range strings.Split(a.ipfsGatewaysInput, ",") {
+		g = strings.TrimSpace(g)
+		if g != "" {
+			gateways = append(gateways, g)
+		}
+	}
+	a.cfg.IPFSGateways = gateways
+
+	client := a.zlibClient()
+	if client == nil {
+		return
+	}
+	client.SetIPFSGateways(gateways)
+}
+
+// applyPreferredFormatsSetting parses a.preferredFormatsInput into
+// cfg.PreferredFormats and pushes it into the concrete Z-Library client.
+func (a *App) applyPreferredFormatsSetting() {
+	var formats []string
+	for _, f := range strings.Split(a.preferredFormatsInput, ",") {
+		f = strings.TrimSpace(f)
+		if f != "" {
+			formats = append(formats, f)
+		}
+	}
+	a.cfg.PreferredFormats = formats
+
+	client := a.zlibClient()
+	if client == nil {
+		return
+	}
+	client.SetPreferredFormats(formats)
+}
+
+// applyMirrorRacingSettings parses a.mirrorCandidatesInput into
+// cfg.MirrorCandidates and pushes both it and cfg.EnableMirrorRacing into
+// the concrete Z-Library client.
+func (a *App) applyMirrorRacingSettings() {
+	var candidates []string
+	for _, c := range strings.Split(a.mirrorCandidatesInput, ",") {
+		c = strings.TrimSpace(c)
+		if c != "" {
+			candidates = append(candidates, c)
+		}
+	}
+	a.cfg.MirrorCandidates = candidates
+
+	client := a.zlibClient()
+	if client == nil {
+		return
+	}
+	client.SetMirrorCandidates(candidates)
+	client.SetMirrorRacing(a.cfg.EnableMirrorRacing)
+}
+
+// applyLanguageSetting pushes cfg.Language into the concrete Z-Library
+// client. A blank value leaves the client's own OS-locale-derived default
+// in place, since it was already set at construction time.
+func (a *App) applyLanguageSetting() {
+	if a.cfg.Language == "" {
+		return
+	}
+	client := a.zlibClient()
+	if client == nil {
+		return
+	}
+	client.SetLanguage(a.cfg.Language)
+}
+
+// applyLazyEnrichmentSetting starts or stops the background enrichment
+// worker to match the checkbox the user just toggled, rather than waiting
+// for the next search.
+func (a *App) applyLazyEnrichmentSetting() {
+	a.stopEnrichmentWorker()
+	a.startEnrichmentWorker()
+}
+
+func (a *App) applyDebugSetting() {
+	client := a.zlibClient()
+	if client == nil {
+		return
+	}
+	if !a.cfg.DebugMode {
+		client.SetDebugSnapshotDir("")
+		return
+	}
+	if dir, err := config.DebugSnapshotPath(); err == nil {
+		client.SetDebugSnapshotDir(dir)
+	}
+}
+
+func (a *App) applyHTTPDumpSetting() {
+	client := a.zlibClient()
+	if client == nil {
+		return
+	}
+	if !a.cfg.HTTPDump {
+		client.SetHTTPDump("")
+		return
+	}
+	if dir, err := config.HTTPDumpPath(); err == nil {
+		client.SetHTTPDump(dir)
+	}
+}
@@ -0,0 +1,50 @@
+package gui
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// statusTransientLifetime is how long a transient status message (a
+// search's "Ready.", a details-pane toast, a rate-limit notice) stays
+// visible before clearing itself, so browsing other books while a
+// download runs can't permanently stomp the persistent download status
+// shown alongside it.
+const statusTransientLifetime = 5 * time.Second
+
+// expireTransientStatus clears a.status once it's been showing the same
+// text for longer than statusTransientLifetime. It's driven from Loop
+// rather than from every individual "a.status = ..." assignment, since
+// those are scattered across every handler in the package and giu's
+// immediate-mode render loop already gives us a natural once-per-frame
+// hook to check elapsed time against.
+func (a *App) expireTransientStatus() {
+	if a.status != a.lastStatusText {
+		a.lastStatusText = a.status
+		a.statusSetAt = time.Now()
+		return
+	}
+	if a.status != "" && time.Since(a.statusSetAt) > statusTransientLifetime {
+		a.status = ""
+		a.lastStatusText = ""
+	}
+}
+
+// downloadStatusText is the status bar's persistent, right-hand region:
+// how many downloads are currently in flight, derived straight from
+// activeDownloads rather than tracked separately, so it can't drift out
+// of sync with the counter ConfirmClose already relies on. There's no
+// per-download throughput telemetry plumbed through download.Manager yet,
+// so this reports a count rather than a speed.
+func (a *App) downloadStatusText() string {
+	n := atomic.LoadInt32(&a.activeDownloads)
+	switch n {
+	case 0:
+		return ""
+	case 1:
+		return "1 download active"
+	default:
+		return fmt.Sprintf("%d downloads active", n)
+	}
+}
@@ -0,0 +1,122 @@
+// Package hook runs an optional external command after a successful
+// download finishes, for workflows like handing the file off to Calibre.
+package hook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/Advik-B/The-Blackbook-Archive/utils"
+)
+
+// defaultTimeout bounds how long a hook command may run before it's killed,
+// used when the caller doesn't specify one.
+const defaultTimeout = 30 * time.Second
+
+// Vars holds the placeholder values available to a hook command template.
+type Vars struct {
+	Path   string
+	Title  string
+	Author string
+	Format string
+}
+
+// Run expands template's placeholders ({path}, {title}, {author}, {format})
+// against vars and executes the resulting command outside a shell - the
+// template is split into argv directly, so no placeholder value can inject
+// additional shell syntax. The returned output is bounded the same way a
+// failed HTTP response's body is (see utils.ReadSnippet); the command's
+// full combined output is still buffered in memory while it runs.
+func Run(ctx context.Context, template string, vars Vars, timeout time.Duration) (output string, err error) {
+	argv, err := expandArgv(template, vars)
+	if err != nil {
+		return "", fmt.Errorf("hook: %w", err)
+	}
+	if len(argv) == 0 {
+		return "", fmt.Errorf("hook: empty command")
+	}
+
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, argv[0], argv[1:]...)
+	out, runErr := cmd.CombinedOutput()
+	output = utils.ReadSnippet(bytes.NewReader(out), utils.DefaultSnippetBytes)
+	if runErr != nil {
+		return output, fmt.Errorf("hook: %s: %w", argv[0], runErr)
+	}
+	return output, nil
+}
+
+// expandArgv splits template into words and substitutes each placeholder in
+// every word with vars' corresponding field, after splitting rather than
+// before - so a title or path containing spaces or quotes lands in a single
+// argv element instead of being re-parsed as shell syntax.
+func expandArgv(template string, vars Vars) ([]string, error) {
+	words, err := splitWords(template)
+	if err != nil {
+		return nil, err
+	}
+
+	replacer := strings.NewReplacer(
+		"{path}", vars.Path,
+		"{title}", vars.Title,
+		"{author}", vars.Author,
+		"{format}", vars.Format,
+	)
+	for i, w := range words {
+		words[i] = replacer.Replace(w)
+	}
+	return words, nil
+}
+
+// splitWords tokenizes s the way a shell would for word-splitting and
+// quoting, without any of a shell's other behavior - no globbing, no
+// variable expansion, no command substitution - so a placeholder value
+// containing shell metacharacters can't do anything but sit there as a
+// literal argument once substituted in.
+func splitWords(s string) ([]string, error) {
+	var words []string
+	var current strings.Builder
+	inWord := false
+	var quote rune
+
+	flush := func() {
+		if inWord {
+			words = append(words, current.String())
+			current.Reset()
+			inWord = false
+		}
+	}
+
+	for _, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t':
+			flush()
+		default:
+			inWord = true
+			current.WriteRune(r)
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote in command template")
+	}
+	flush()
+	return words, nil
+}
@@ -0,0 +1,48 @@
+package hook
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestExpandArgvSubstitutesPlaceholdersAfterSplitting(t *testing.T) {
+	argv, err := expandArgv(`calibredb add "{path}" --title "{title}"`, Vars{
+		Path:  "/tmp/Some Book.epub",
+		Title: "Some Book",
+	})
+	if err != nil {
+		t.Fatalf("expandArgv: %v", err)
+	}
+
+	want := []string{"calibredb", "add", "/tmp/Some Book.epub", "--title", "Some Book"}
+	if len(argv) != len(want) {
+		t.Fatalf("argv = %q, want %q", argv, want)
+	}
+	for i := range want {
+		if argv[i] != want[i] {
+			t.Errorf("argv[%d] = %q, want %q", i, argv[i], want[i])
+		}
+	}
+}
+
+func TestExpandArgvRejectsUnterminatedQuote(t *testing.T) {
+	if _, err := expandArgv(`calibredb add "{path}`, Vars{Path: "x"}); err == nil {
+		t.Fatal("expandArgv() = nil error, want one for an unterminated quote")
+	}
+}
+
+func TestRunCapturesOutputFromExpandedCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test runs the POSIX echo command, which Windows lacks")
+	}
+
+	out, err := Run(context.Background(), `echo {title}`, Vars{Title: "hello"}, 0)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if !strings.Contains(out, "hello") {
+		t.Errorf("Run() output = %q, want it to contain %q", out, "hello")
+	}
+}
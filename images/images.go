@@ -0,0 +1,124 @@
+// Package images provides shared hardening for fetching an image over
+// HTTP: validating Content-Type, bounding the response size, and honoring
+// a context deadline, before ever handing the bytes to image.Decode. It
+// exists so the cover-thumbnail pipeline doesn't have to duplicate this
+// logic, and a mirror returning an oversized HTML error page in place of a
+// cover fails fast with a clear error instead of burning memory or
+// surfacing a confusing decode failure.
+package images
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// DefaultMaxBytes is the response size cap Fetch falls back to when
+// maxBytes is <= 0.
+const DefaultMaxBytes = 10 << 20
+
+// ErrUnexpectedContentType is returned when the response's Content-Type
+// isn't an image/* type - most often an HTML error or challenge page
+// served in place of the real image.
+var ErrUnexpectedContentType = errors.New("images: response was not an image")
+
+// ErrTooLarge is returned when the response body exceeds maxBytes.
+var ErrTooLarge = errors.New("images: response exceeded the size limit")
+
+// ErrTruncated is returned when the response body is shorter than the
+// Content-Length the server promised - a connection dropped mid-transfer,
+// most often seen as a corrupted or blank-looking cover. It's checked
+// before decoding so a truncated download fails clearly instead of either
+// an opaque image.Decode error or, worse, a partial image that decodes
+// successfully but is missing its bottom rows.
+var ErrTruncated = errors.New("images: response body was truncated")
+
+// Fetch retrieves url with client, rejecting a non-image Content-Type and
+// capping the body at maxBytes (DefaultMaxBytes if <= 0), then decodes it.
+// ctx governs both the request and the read, so a caller can bound the
+// whole fetch with a single timeout.
+func Fetch(ctx context.Context, client *http.Client, url string, maxBytes int64) (image.Image, error) {
+	return FetchWithProgress(ctx, client, url, maxBytes, nil)
+}
+
+// FetchWithProgress is Fetch, additionally calling progress - if non-nil -
+// after every chunk read from the response body, with the bytes read so
+// far and the total the server reported (0 if it didn't send a
+// Content-Length). Callers that don't need progress should use Fetch.
+func FetchWithProgress(ctx context.Context, client *http.Client, url string, maxBytes int64, progress func(read, total int64)) (image.Image, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBytes
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("images: fetch: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if ct := resp.Header.Get("Content-Type"); ct != "" && !strings.HasPrefix(ct, "image/") {
+		return nil, ErrUnexpectedContentType
+	}
+
+	total := resp.ContentLength
+	if total < 0 {
+		total = 0
+	}
+
+	body := io.Reader(resp.Body)
+	if progress != nil {
+		body = &progressReader{r: body, total: total, onRead: progress}
+	}
+
+	data, err := io.ReadAll(io.LimitReader(body, maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("images: read: %w", err)
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, ErrTooLarge
+	}
+	if resp.ContentLength > 0 && int64(len(data)) < resp.ContentLength {
+		return nil, ErrTruncated
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("images: decode: %w", err)
+	}
+	return img, nil
+}
+
+// progressReader wraps an io.Reader, calling onRead with the running total
+// of bytes read and the overall expected total after every chunk, so a
+// caller can show a loading indicator for a fetch that's expected to take
+// a while (e.g. a full-resolution cover rather than a thumbnail).
+type progressReader struct {
+	r      io.Reader
+	total  int64
+	read   int64
+	onRead func(read, total int64)
+}
+
+func (p *progressReader) Read(buf []byte) (int, error) {
+	n, err := p.r.Read(buf)
+	if n > 0 {
+		p.read += int64(n)
+		p.onRead(p.read, p.total)
+	}
+	return n, err
+}
@@ -0,0 +1,136 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func encodedPNG(t *testing.T) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	img.Set(0, 0, color.White)
+
+	var buf []byte
+	w := &byteSliceWriter{&buf}
+	if err := png.Encode(w, img); err != nil {
+		t.Fatalf("png.Encode: %v", err)
+	}
+	return buf
+}
+
+type byteSliceWriter struct{ buf *[]byte }
+
+func (w *byteSliceWriter) Write(p []byte) (int, error) {
+	*w.buf = append(*w.buf, p...)
+	return len(p), nil
+}
+
+func TestFetchDecodesAValidImage(t *testing.T) {
+	data := encodedPNG(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(data)
+	}))
+	defer server.Close()
+
+	img, err := Fetch(context.Background(), nil, server.URL, 0)
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if img.Bounds().Dx() != 4 || img.Bounds().Dy() != 4 {
+		t.Errorf("decoded image is %v, want 4x4", img.Bounds())
+	}
+}
+
+func TestFetchRejectsNonImageContentType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte("<html><body>rate limited</body></html>"))
+	}))
+	defer server.Close()
+
+	_, err := Fetch(context.Background(), nil, server.URL, 0)
+	if err != ErrUnexpectedContentType {
+		t.Fatalf("Fetch err = %v, want %v", err, ErrUnexpectedContentType)
+	}
+}
+
+func TestFetchRejectsOversizedBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(make([]byte, 64))
+	}))
+	defer server.Close()
+
+	_, err := Fetch(context.Background(), nil, server.URL, 16)
+	if err != ErrTooLarge {
+		t.Fatalf("Fetch err = %v, want %v", err, ErrTooLarge)
+	}
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper, letting a test
+// hand Fetch a response with a Content-Length that doesn't match how much
+// body data actually arrives - the connection-dropped-mid-transfer case a
+// real server wouldn't let us construct reliably over a live listener.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestFetchRejectsTruncatedBody(t *testing.T) {
+	data := encodedPNG(t)
+	short := data[:len(data)-10]
+
+	client := &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode:    http.StatusOK,
+				Header:        http.Header{"Content-Type": []string{"image/png"}},
+				Body:          io.NopCloser(bytes.NewReader(short)),
+				ContentLength: int64(len(data)),
+			}, nil
+		}),
+	}
+
+	_, err := Fetch(context.Background(), client, "http://example.invalid/cover.png", 0)
+	if err != ErrTruncated {
+		t.Fatalf("Fetch err = %v, want %v", err, ErrTruncated)
+	}
+}
+
+func TestFetchWithProgressReportsRunningTotal(t *testing.T) {
+	data := encodedPNG(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Write(data)
+	}))
+	defer server.Close()
+
+	var lastRead, lastTotal int64
+	var calls int
+	_, err := FetchWithProgress(context.Background(), nil, server.URL, 0, func(read, total int64) {
+		calls++
+		lastRead, lastTotal = read, total
+	})
+	if err != nil {
+		t.Fatalf("FetchWithProgress: %v", err)
+	}
+
+	if calls == 0 {
+		t.Fatal("expected progress to be called at least once")
+	}
+	if lastRead != int64(len(data)) {
+		t.Errorf("final read = %d, want %d", lastRead, len(data))
+	}
+	if lastTotal != int64(len(data)) {
+		t.Errorf("total = %d, want %d", lastTotal, len(data))
+	}
+}
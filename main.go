@@ -0,0 +1,38 @@
+// The Blackbook Archive is a desktop client for searching, inspecting, and
+// downloading books from Z-Library mirrors.
+package main
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/AllenDang/giu"
+
+	"github.com/Advik-B/The-Blackbook-Archive/config"
+	"github.com/Advik-B/The-Blackbook-Archive/crashreport"
+	"github.com/Advik-B/The-Blackbook-Archive/gui"
+)
+
+func main() {
+	defer crashreport.Recover(crashReportDir())
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Printf("config: using defaults: %v", err)
+	}
+
+	app := gui.NewApp(cfg)
+
+	wnd := giu.NewMasterWindow("The Blackbook Archive", 1280, 800, 0)
+	wnd.SetCloseCallback(app.ConfirmClose)
+	wnd.Run(app.Loop)
+}
+
+func crashReportDir() string {
+	path, err := config.Path()
+	if err != nil {
+		return os.TempDir()
+	}
+	return filepath.Dir(path)
+}
@@ -0,0 +1,56 @@
+// Package prefetch runs a small bounded worker pool over a list of items,
+// shared by any frontend that wants to warm a cache (e.g. cover
+// thumbnails) for a fresh result set before the user scrolls to it,
+// without opening a connection per item at once.
+package prefetch
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultWorkers is the pool size Run falls back to when workers <= 0.
+const DefaultWorkers = 3
+
+// Run calls fetch for each item in items, bounded to workers concurrent
+// calls (DefaultWorkers if workers <= 0). It returns once every item has
+// been attempted or ctx is cancelled, whichever comes first - a caller
+// starting a new search cancels ctx to drop whatever's left of the
+// previous one's queue.
+func Run(ctx context.Context, items []string, workers int, fetch func(item string)) {
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+
+	queue := make(chan string)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case item, ok := <-queue:
+					if !ok {
+						return
+					}
+					fetch(item)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+feed:
+	for _, item := range items {
+		select {
+		case queue <- item:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(queue)
+	wg.Wait()
+}
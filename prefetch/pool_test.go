@@ -0,0 +1,58 @@
+package prefetch
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunBoundsConcurrency(t *testing.T) {
+	items := make([]string, 20)
+	for i := range items {
+		items[i] = "item"
+	}
+
+	var current, maxSeen int32
+	var mu sync.Mutex
+
+	Run(context.Background(), items, 3, func(item string) {
+		n := atomic.AddInt32(&current, 1)
+		mu.Lock()
+		if n > maxSeen {
+			maxSeen = n
+		}
+		mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&current, -1)
+	})
+
+	if maxSeen > 3 {
+		t.Errorf("max concurrent fetch() calls = %d, want <= 3", maxSeen)
+	}
+	if maxSeen < 2 {
+		t.Errorf("max concurrent fetch() calls = %d, want workers to actually overlap", maxSeen)
+	}
+}
+
+func TestRunStopsOnCancel(t *testing.T) {
+	items := make([]string, 100)
+	for i := range items {
+		items[i] = "item"
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var count int32
+	Run(ctx, items, 2, func(item string) {
+		if atomic.AddInt32(&count, 1) == 3 {
+			cancel()
+		}
+		time.Sleep(time.Millisecond)
+	})
+
+	if got := atomic.LoadInt32(&count); got >= int32(len(items)) {
+		t.Errorf("fetch() ran %d times, want cancellation to cut it short of all %d items", got, len(items))
+	}
+}
@@ -0,0 +1,131 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+const (
+	libgenSourceName  = "Library Genesis"
+	libgenDefaultHost = "https://libgen.is"
+)
+
+// LibGenSource implements Provider against a Library Genesis mirror. Its
+// search and detail pages are plain HTML tables, much simpler to parse
+// than Z-Library's, but it has no concept of a details "page" separate
+// from the search row - md5 is the only stable identifier.
+type LibGenSource struct {
+	httpClient *http.Client
+	host       string
+}
+
+// NewLibGenSource returns a Provider backed by the given Library Genesis
+// mirror host (e.g. "https://libgen.is"). An empty host uses the default.
+func NewLibGenSource(host string) *LibGenSource {
+	if host == "" {
+		host = libgenDefaultHost
+	}
+	return &LibGenSource{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		host:       host,
+	}
+}
+
+func (s *LibGenSource) Name() string { return libgenSourceName }
+
+func (s *LibGenSource) Search(ctx context.Context, query string, page int) ([]zlibrary.BookSearchResult, error) {
+	searchURL := fmt.Sprintf("%s/search.php?req=%s&page=%d", s.host, url.QueryEscape(query), page)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, searchURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("libgen: search request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("libgen: parse search page: %w", err)
+	}
+
+	var results []zlibrary.BookSearchResult
+	doc.Find("table.c tr").Each(func(i int, row *goquery.Selection) {
+		if i == 0 {
+			return // header row
+		}
+		cells := row.Find("td")
+		if cells.Length() < 9 {
+			return
+		}
+
+		titleLink := cells.Eq(2).Find("a").First()
+		detailsURL, _ := titleLink.Attr("href")
+		if detailsURL != "" && !strings.HasPrefix(detailsURL, "http") {
+			detailsURL = s.host + "/" + detailsURL
+		}
+
+		results = append(results, zlibrary.BookSearchResult{
+			Source:     libgenSourceName,
+			Title:      strings.TrimSpace(titleLink.Text()),
+			Author:     strings.TrimSpace(cells.Eq(1).Text()),
+			Year:       strings.TrimSpace(cells.Eq(4).Text()),
+			Format:     strings.TrimSpace(cells.Eq(8).Text()),
+			SizeText:   strings.TrimSpace(cells.Eq(7).Text()),
+			DetailsURL: detailsURL,
+		})
+	})
+
+	return results, nil
+}
+
+func (s *LibGenSource) Details(ctx context.Context, detailsURL string) (*zlibrary.BookDetails, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, detailsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("libgen: details request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("libgen: parse details page: %w", err)
+	}
+
+	details := &zlibrary.BookDetails{
+		BookSearchResult: zlibrary.BookSearchResult{
+			Source:     libgenSourceName,
+			DetailsURL: detailsURL,
+			Title:      strings.TrimSpace(doc.Find("h1").First().Text()),
+		},
+	}
+
+	doc.Find("a").Each(func(_ int, a *goquery.Selection) {
+		href, _ := a.Attr("href")
+		if strings.Contains(href, "/get.php") || strings.Contains(a.Text(), "GET") {
+			details.DownloadURL = href
+		}
+	})
+
+	return details, nil
+}
+
+func (s *LibGenSource) DownloadRequest(ctx context.Context, details *zlibrary.BookDetails, format string) (*http.Request, error) {
+	if details.DownloadURL == "" {
+		return nil, fmt.Errorf("libgen: no download URL resolved for %q", details.Title)
+	}
+	return http.NewRequestWithContext(ctx, http.MethodGet, details.DownloadURL, nil)
+}
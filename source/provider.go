@@ -0,0 +1,35 @@
+// Package source defines the pluggable backend interface that lets the
+// rest of the app search, inspect, and download books without caring
+// which site they actually came from.
+package source
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+// Provider is implemented by every book source the app can search against -
+// this is the BookSource abstraction: the UI holds a map of these rather
+// than calling any concrete backend's package functions directly, so a test
+// can inject a fake Provider without touching gui code, and a future
+// backend only has to satisfy this interface to be searchable, viewable,
+// and downloadable like any other. A result produced by one provider
+// always routes its Details and DownloadRequest calls back through that
+// same provider.
+type Provider interface {
+	// Name identifies the provider for display and for routing a
+	// BookSearchResult.Source/BookDetails.Source back to its origin.
+	Name() string
+
+	// Search returns the results found on the given 1-indexed page.
+	Search(ctx context.Context, query string, page int) ([]zlibrary.BookSearchResult, error)
+
+	// Details fetches and parses a single book's details page.
+	Details(ctx context.Context, detailsURL string) (*zlibrary.BookDetails, error)
+
+	// DownloadRequest builds the HTTP request that fetches the given
+	// format of a book.
+	DownloadRequest(ctx context.Context, details *zlibrary.BookDetails, format string) (*http.Request, error)
+}
@@ -0,0 +1,48 @@
+package source
+
+import "sync"
+
+// registry holds every Provider registered via RegisterSource, in
+// registration order, so NewApp (and any future caller that wants a full
+// source list) doesn't need to hand-build its own map of backends.
+var registry = struct {
+	mu     sync.Mutex
+	byName map[string]Provider
+	names  []string
+}{byName: make(map[string]Provider)}
+
+// RegisterSource makes src available under name to every caller of
+// Sources/SourceNames. Registering the same name twice replaces the
+// previous Provider without changing its position in SourceNames' order.
+func RegisterSource(name string, src Provider) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if _, exists := registry.byName[name]; !exists {
+		registry.names = append(registry.names, name)
+	}
+	registry.byName[name] = src
+}
+
+// Sources returns every registered Provider, keyed by name.
+func Sources() map[string]Provider {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	sources := make(map[string]Provider, len(registry.byName))
+	for name, src := range registry.byName {
+		sources[name] = src
+	}
+	return sources
+}
+
+// SourceNames returns every registered source's name, in the order it was
+// first registered.
+func SourceNames() []string {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	names := make([]string, len(registry.names))
+	copy(names, registry.names)
+	return names
+}
@@ -0,0 +1,46 @@
+package source
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+// stubProvider is a minimal Provider for exercising the registry without
+// pulling in a real backend.
+type stubProvider struct{ name string }
+
+func (s stubProvider) Name() string { return s.name }
+func (s stubProvider) Search(ctx context.Context, query string, page int) ([]zlibrary.BookSearchResult, error) {
+	return nil, nil
+}
+func (s stubProvider) Details(ctx context.Context, detailsURL string) (*zlibrary.BookDetails, error) {
+	return nil, nil
+}
+func (s stubProvider) DownloadRequest(ctx context.Context, details *zlibrary.BookDetails, format string) (*http.Request, error) {
+	return nil, nil
+}
+
+func TestRegisterSourcePreservesOrderAndReplacesByName(t *testing.T) {
+	registry.mu.Lock()
+	registry.byName = make(map[string]Provider)
+	registry.names = nil
+	registry.mu.Unlock()
+
+	RegisterSource("a", stubProvider{name: "a"})
+	RegisterSource("b", stubProvider{name: "b"})
+
+	replacement := stubProvider{name: "a-v2"}
+	RegisterSource("a", replacement)
+
+	if got := SourceNames(); len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Fatalf("SourceNames() = %v, want [a b]", got)
+	}
+
+	sources := Sources()
+	if sources["a"] != Provider(replacement) {
+		t.Errorf("Sources()[\"a\"] was not replaced by the second RegisterSource call")
+	}
+}
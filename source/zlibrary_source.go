@@ -0,0 +1,32 @@
+package source
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Advik-B/The-Blackbook-Archive/zlibrary"
+)
+
+// ZLibrarySource adapts a *zlibrary.Client to the Provider interface.
+type ZLibrarySource struct {
+	Client *zlibrary.Client
+}
+
+// NewZLibrarySource wraps client as a Provider.
+func NewZLibrarySource(client *zlibrary.Client) *ZLibrarySource {
+	return &ZLibrarySource{Client: client}
+}
+
+func (s *ZLibrarySource) Name() string { return zlibrary.SourceName }
+
+func (s *ZLibrarySource) Search(ctx context.Context, query string, page int) ([]zlibrary.BookSearchResult, error) {
+	return s.Client.SearchZLibrary(ctx, query, page)
+}
+
+func (s *ZLibrarySource) Details(ctx context.Context, detailsURL string) (*zlibrary.BookDetails, error) {
+	return s.Client.GetBookDetails(ctx, detailsURL)
+}
+
+func (s *ZLibrarySource) DownloadRequest(ctx context.Context, details *zlibrary.BookDetails, format string) (*http.Request, error) {
+	return s.Client.DownloadRequest(ctx, details, format)
+}
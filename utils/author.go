@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// authorParticles are lowercase name particles that stay lowercase under
+// title-casing, e.g. "Ludwig van Beethoven" rather than an all-caps or
+// all-lowercase scrape turning "van" into "Van". Only applied mid-name, not
+// when a particle is the first word.
+var authorParticles = map[string]bool{
+	"de": true, "van": true, "von": true, "der": true, "den": true,
+	"di": true, "da": true, "al": true,
+}
+
+// NormalizeAuthor cleans a single scraped author name for display and for
+// use in filenames and grouping keys: collapsing whitespace, undoing a
+// "Last, First" inversion, and - for a name that arrived in ALL CAPS or
+// all lowercase - title-casing it word by word while leaving recognized
+// particles alone. A name that's already mixed-case is assumed to be
+// styled deliberately (e.g. "iPhone" is a thing, and so is a stylized
+// pen name) and is left as-is beyond whitespace/inversion cleanup.
+//
+// It operates on one author at a time; a scraped field listing multiple
+// authors should go through SplitAuthors first.
+func NormalizeAuthor(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if s == "" {
+		return ""
+	}
+
+	if last, first, ok := strings.Cut(s, ","); ok && strings.TrimSpace(first) != "" {
+		s = strings.TrimSpace(first) + " " + strings.TrimSpace(last)
+	}
+
+	if isAllCaps(s) || isAllLower(s) {
+		s = titleCaseName(s)
+	}
+
+	return s
+}
+
+// SplitAuthors splits a scraped author field into its individual authors,
+// on ";" or "&" (both common separators for a multi-author credit line),
+// normalizing each one. The caller typically wants authors[0] for a
+// filename and the full slice for display.
+func SplitAuthors(s string) []string {
+	s = strings.ReplaceAll(s, "&", ";")
+	parts := strings.Split(s, ";")
+
+	authors := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if name := NormalizeAuthor(p); name != "" {
+			authors = append(authors, name)
+		}
+	}
+	return authors
+}
+
+// FormatAuthors normalizes a scraped author field - which may credit one
+// author or several, separated inconsistently depending on the source
+// mirror - into a single comma-separated display string, e.g. "Frank
+// Herbert, Brian Herbert". Returns "" when s names nobody.
+func FormatAuthors(s string) string {
+	return strings.Join(SplitAuthors(s), ", ")
+}
+
+func isAllCaps(s string) bool {
+	hasLetter := false
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			hasLetter = true
+			if unicode.IsLower(r) {
+				return false
+			}
+		}
+	}
+	return hasLetter
+}
+
+func isAllLower(s string) bool {
+	hasLetter := false
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			hasLetter = true
+			if unicode.IsUpper(r) {
+				return false
+			}
+		}
+	}
+	return hasLetter
+}
+
+// titleCaseName title-cases each word of s, leaving a recognized name
+// particle lowercase unless it's the first word.
+func titleCaseName(s string) string {
+	words := strings.Fields(s)
+	for i, w := range words {
+		lower := strings.ToLower(w)
+		if i > 0 && authorParticles[lower] {
+			words[i] = lower
+			continue
+		}
+		words[i] = titleCaseWord(lower)
+	}
+	return strings.Join(words, " ")
+}
+
+// titleCaseWord uppercases the first letter of w, leaving the rest as is
+// (titleCaseName passes it already lowercased), so a hyphenated name like
+// "Smith-Jones" is capitalized on both halves.
+func titleCaseWord(w string) string {
+	parts := strings.Split(w, "-")
+	for i, p := range parts {
+		if p == "" {
+			continue
+		}
+		r := []rune(p)
+		r[0] = unicode.ToUpper(r[0])
+		parts[i] = string(r)
+	}
+	return strings.Join(parts, "-")
+}
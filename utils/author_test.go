@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeAuthor(t *testing.T) {
+	cases := map[string]string{
+		"Jane Doe":          "Jane Doe",
+		"Doe, Jane":         "Jane Doe",
+		"JANE DOE":          "Jane Doe",
+		"jane doe":          "Jane Doe",
+		"  Jane   Doe  ":    "Jane Doe",
+		"Ursula K. Le Guin": "Ursula K. Le Guin",
+		"URSULA K. LE GUIN": "Ursula K. Le Guin",
+		"":                  "",
+	}
+	for in, want := range cases {
+		if got := NormalizeAuthor(in); got != want {
+			t.Errorf("NormalizeAuthor(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestSplitAuthors(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{"Jane Doe; John Smith", []string{"Jane Doe", "John Smith"}},
+		{"Jane Doe & John Smith", []string{"Jane Doe", "John Smith"}},
+		{"Jane Doe", []string{"Jane Doe"}},
+		{"", nil},
+	}
+	for _, tc := range cases {
+		got := SplitAuthors(tc.in)
+		if len(got) == 0 {
+			got = nil
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("SplitAuthors(%q) = %v, want %v", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestFormatAuthors(t *testing.T) {
+	cases := map[string]string{
+		"Jane Doe; John Smith":  "Jane Doe, John Smith",
+		"Jane Doe & John Smith": "Jane Doe, John Smith",
+		"Doe, Jane":             "Jane Doe",
+		"":                      "",
+	}
+	for in, want := range cases {
+		if got := FormatAuthors(in); got != want {
+			t.Errorf("FormatAuthors(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package utils
+
+import "fmt"
+
+// FormatOptions controls how FormatBytesWith renders a byte count.
+type FormatOptions struct {
+	// Decimal uses 1000-based (SI) units ("kB", "MB") instead of the
+	// default 1024-based ("KB", "MB").
+	Decimal bool
+
+	// IEC uses the full IEC suffixes ("KiB", "MiB") instead of the
+	// default short ones ("KB", "MB"). Only meaningful when Decimal is
+	// false - IEC units are always binary.
+	IEC bool
+}
+
+var (
+	binaryShortUnits = []string{"B", "KB", "MB", "GB", "TB", "PB"}
+	binaryIECUnits   = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB"}
+	decimalUnits     = []string{"B", "kB", "MB", "GB", "TB", "PB"}
+)
+
+// FormatBytes renders b using the default style: 1024-based units with
+// short suffixes (e.g. "1.5 MB"). It's a thin wrapper over FormatBytesWith
+// for the common case.
+func FormatBytes(b int64) string {
+	return FormatBytesWith(b, FormatOptions{})
+}
+
+// FormatBytesWith renders b as a human-readable size under the given
+// options. Values under the base (1024, or 1000 when opts.Decimal is set)
+// are always shown in plain bytes, with no decimal point.
+func FormatBytesWith(b int64, opts FormatOptions) string {
+	base := float64(1024)
+	units := binaryShortUnits
+	if opts.Decimal {
+		base = 1000
+		units = decimalUnits
+	} else if opts.IEC {
+		units = binaryIECUnits
+	}
+
+	if b < int64(base) {
+		return fmt.Sprintf("%d %s", b, units[0])
+	}
+
+	value := float64(b)
+	unit := 0
+	for value >= base && unit < len(units)-1 {
+		value /= base
+		unit++
+	}
+
+	return fmt.Sprintf("%.1f %s", value, units[unit])
+}
@@ -0,0 +1,31 @@
+package utils
+
+import "testing"
+
+func TestFormatBytesBoundaries(t *testing.T) {
+	cases := []struct {
+		b    int64
+		want string
+	}{
+		{1023, "1023 B"},
+		{1024, "1.0 KB"},
+		{1000000, "976.6 KB"},
+	}
+	for _, c := range cases {
+		if got := FormatBytes(c.b); got != c.want {
+			t.Errorf("FormatBytes(%d) = %q, want %q", c.b, got, c.want)
+		}
+	}
+}
+
+func TestFormatBytesWithDecimal(t *testing.T) {
+	if got := FormatBytesWith(1000000, FormatOptions{Decimal: true}); got != "1.0 MB" {
+		t.Errorf("decimal FormatBytesWith(1000000) = %q, want %q", got, "1.0 MB")
+	}
+}
+
+func TestFormatBytesWithIEC(t *testing.T) {
+	if got := FormatBytesWith(1024, FormatOptions{IEC: true}); got != "1.0 KiB" {
+		t.Errorf("IEC FormatBytesWith(1024) = %q, want %q", got, "1.0 KiB")
+	}
+}
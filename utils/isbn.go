@@ -0,0 +1,173 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+)
+
+// NormalizeISBN strips hyphens and whitespace from s, so input copied from
+// a spreadsheet or pasted with formatting still validates and compares
+// consistently.
+func NormalizeISBN(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r == '-' || r == ' ' {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return strings.ToUpper(b.String())
+}
+
+// IsValidISBN reports whether s is a structurally valid ISBN-10 or
+// ISBN-13, checksum included. Hyphens and whitespace are ignored.
+func IsValidISBN(s string) bool {
+	isbn := NormalizeISBN(s)
+	switch len(isbn) {
+	case 10:
+		return isValidISBN10(isbn)
+	case 13:
+		return isValidISBN13(isbn)
+	default:
+		return false
+	}
+}
+
+func isValidISBN10(isbn string) bool {
+	sum := 0
+	for i := 0; i < 10; i++ {
+		digit := 0
+		if i == 9 && isbn[i] == 'X' {
+			digit = 10
+		} else {
+			d, err := strconv.Atoi(string(isbn[i]))
+			if err != nil {
+				return false
+			}
+			digit = d
+		}
+		sum += digit * (10 - i)
+	}
+	return sum%11 == 0
+}
+
+func isValidISBN13(isbn string) bool {
+	sum := 0
+	for i := 0; i < 13; i++ {
+		d, err := strconv.Atoi(string(isbn[i]))
+		if err != nil {
+			return false
+		}
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += d * weight
+	}
+	return sum%10 == 0
+}
+
+// isbn10To13 converts a validated ISBN-10 to its ISBN-13 equivalent by
+// prefixing the Bookland "978" EAN prefix and recomputing the check digit.
+func isbn10To13(isbn10 string) string {
+	core := "978" + isbn10[:9]
+	sum := 0
+	for i := 0; i < 12; i++ {
+		d, _ := strconv.Atoi(string(core[i]))
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += d * weight
+	}
+	check := (10 - sum%10) % 10
+	return core + strconv.Itoa(check)
+}
+
+// isbn13To10 converts a validated ISBN-13 to its ISBN-10 equivalent,
+// returning ok=false if isbn13 isn't in the "978" Bookland range - ISBNs
+// issued under the newer "979" range have no ISBN-10 form.
+func isbn13To10(isbn13 string) (isbn10 string, ok bool) {
+	if !strings.HasPrefix(isbn13, "978") {
+		return "", false
+	}
+	core := isbn13[3:12]
+	sum := 0
+	for i := 0; i < 9; i++ {
+		d, _ := strconv.Atoi(string(core[i]))
+		sum += d * (10 - i)
+	}
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return core + "X", true
+	}
+	return core + strconv.Itoa(check), true
+}
+
+// CanonicalizeISBN cleans a single scraped ISBN field - stripping hyphens
+// and whitespace, as NormalizeISBN does - and, if what's left validates as
+// either an ISBN-10 or an ISBN-13, returns both forms: the one it parsed
+// as, and the other derived from it by conversion. It's the building block
+// NormalizeISBNPair uses to correct a site that put an ISBN-13 in the
+// ISBN-10 slot or vice versa, rather than just reporting it invalid.
+//
+// ok is false, and both return values are "", for input that doesn't
+// validate as either length once cleaned. isbn10 is "" on a valid "979"
+// range ISBN-13, which has no ISBN-10 equivalent.
+func CanonicalizeISBN(s string) (isbn10, isbn13 string, ok bool) {
+	isbn := NormalizeISBN(s)
+	switch len(isbn) {
+	case 10:
+		if !isValidISBN10(isbn) {
+			return "", "", false
+		}
+		return isbn, isbn10To13(isbn), true
+	case 13:
+		if !isValidISBN13(isbn) {
+			return "", "", false
+		}
+		isbn10, _ = isbn13To10(isbn)
+		return isbn10, isbn, true
+	default:
+		return "", "", false
+	}
+}
+
+// HyphenateISBN inserts hyphens into a clean, unhyphenated ISBN-10 or
+// ISBN-13 for display. It doesn't consult the ISBN Agency's registration
+// range tables - there's no bundled copy of them - so the group and
+// publisher segments it produces are a readability aid, not an officially
+// accurate split; the digits themselves are untouched, and that's what
+// matters for lookup and comparison. Input whose length isn't 10 or 13 is
+// returned unchanged; the characters themselves are not checked.
+func HyphenateISBN(isbn string) string {
+	switch len(isbn) {
+	case 10:
+		return isbn[:1] + "-" + isbn[1:6] + "-" + isbn[6:9] + "-" + isbn[9:]
+	case 13:
+		return isbn[:3] + "-" + isbn[3:4] + "-" + isbn[4:9] + "-" + isbn[9:12] + "-" + isbn[12:]
+	default:
+		return isbn
+	}
+}
+
+// NormalizeISBNPair takes a book's scraped ISBN-10 and ISBN-13 fields -
+// however noisy or hyphenated - and returns their corrected canonical
+// forms, swapping them back into the right slot if the site put them in
+// backwards. A missing variant is derived from the other when that one
+// validates (an ISBN-10 from a "978" ISBN-13, an ISBN-13 from an ISBN-10);
+// a return value is "" only when neither field yields that variant.
+func NormalizeISBNPair(rawISBN10, rawISBN13 string) (isbn10, isbn13 string) {
+	if got10, got13, ok := CanonicalizeISBN(rawISBN10); ok {
+		isbn10, isbn13 = got10, got13
+	}
+	if got10, got13, ok := CanonicalizeISBN(rawISBN13); ok {
+		if isbn13 == "" {
+			isbn13 = got13
+		}
+		if isbn10 == "" {
+			isbn10 = got10
+		}
+	}
+	return isbn10, isbn13
+}
@@ -0,0 +1,82 @@
+package utils
+
+import "testing"
+
+func TestIsValidISBN(t *testing.T) {
+	cases := map[string]bool{
+		"0-306-40615-2": true,
+		"9780306406157": true,
+		"0306406151":    false, // bad check digit
+		"123":           false,
+		"":              false,
+	}
+	for in, want := range cases {
+		if got := IsValidISBN(in); got != want {
+			t.Errorf("IsValidISBN(%q) = %v, want %v", in, got, want)
+		}
+	}
+}
+
+func TestNormalizeISBN(t *testing.T) {
+	if got := NormalizeISBN("0-306-40615-2"); got != "0306406152" {
+		t.Errorf("NormalizeISBN() = %q, want 0306406152", got)
+	}
+}
+
+func TestCanonicalizeISBN(t *testing.T) {
+	cases := []struct {
+		name       string
+		in         string
+		wantISBN10 string
+		wantISBN13 string
+		wantOK     bool
+	}{
+		{"hyphenated isbn-10", "0-306-40615-2", "0306406152", "9780306406157", true},
+		{"bare isbn-13", "9780306406157", "0306406152", "9780306406157", true},
+		{"979-range isbn-13 has no isbn-10", "9791234567896", "", "9791234567896", true},
+		{"bad check digit", "0306406151", "", "", false},
+		{"too short", "123", "", "", false},
+		{"empty", "", "", "", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			isbn10, isbn13, ok := CanonicalizeISBN(tc.in)
+			if ok != tc.wantOK || isbn10 != tc.wantISBN10 || isbn13 != tc.wantISBN13 {
+				t.Errorf("CanonicalizeISBN(%q) = (%q, %q, %v), want (%q, %q, %v)",
+					tc.in, isbn10, isbn13, ok, tc.wantISBN10, tc.wantISBN13, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestNormalizeISBNPairCorrectsSwappedSlots(t *testing.T) {
+	// The site put the ISBN-13 value in the ISBN-10 slot and vice versa.
+	isbn10, isbn13 := NormalizeISBNPair("9780306406157", "0-306-40615-2")
+	if isbn10 != "0306406152" || isbn13 != "9780306406157" {
+		t.Errorf("NormalizeISBNPair(swapped) = (%q, %q), want (0306406152, 9780306406157)", isbn10, isbn13)
+	}
+}
+
+func TestNormalizeISBNPairClearsInvalidValues(t *testing.T) {
+	isbn10, isbn13 := NormalizeISBNPair("not an isbn", "9780306406157")
+	if isbn10 != "0306406152" || isbn13 != "9780306406157" {
+		t.Errorf("NormalizeISBNPair(corrupt isbn10) = (%q, %q), want (0306406152, 9780306406157)", isbn10, isbn13)
+	}
+
+	isbn10, isbn13 = NormalizeISBNPair("garbage", "also garbage")
+	if isbn10 != "" || isbn13 != "" {
+		t.Errorf("NormalizeISBNPair(all corrupt) = (%q, %q), want (\"\", \"\")", isbn10, isbn13)
+	}
+}
+
+func TestHyphenateISBN(t *testing.T) {
+	if got := HyphenateISBN("0306406152"); got != "0-30640-615-2" {
+		t.Errorf("HyphenateISBN(isbn-10) = %q, want 0-30640-615-2", got)
+	}
+	if got := HyphenateISBN("9780306406157"); got != "978-0-30640-615-7" {
+		t.Errorf("HyphenateISBN(isbn-13) = %q, want 978-0-30640-615-7", got)
+	}
+	if got := HyphenateISBN("123"); got != "123" {
+		t.Errorf("HyphenateISBN(invalid length) = %q, want unchanged 123", got)
+	}
+}
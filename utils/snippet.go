@@ -0,0 +1,23 @@
+// Package utils holds small helpers shared across the app's packages that
+// don't belong to any one of them.
+package utils
+
+import "io"
+
+// DefaultSnippetBytes is the cap most callers pass to ReadSnippet.
+const DefaultSnippetBytes = 4096
+
+// ReadSnippet reads at most max bytes from r and returns them as a string,
+// appending a truncation marker if there was more to read. It exists so
+// error paths that capture a failed response's body for display can't
+// accidentally pull megabytes of HTML into memory and into a dialog.
+func ReadSnippet(r io.Reader, max int) string {
+	data, err := io.ReadAll(io.LimitReader(r, int64(max)+1))
+	if err != nil {
+		return string(data)
+	}
+	if len(data) > max {
+		return string(data[:max]) + "... (truncated)"
+	}
+	return string(data)
+}
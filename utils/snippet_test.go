@@ -0,0 +1,19 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadSnippet(t *testing.T) {
+	short := "hello"
+	if got := ReadSnippet(strings.NewReader(short), 10); got != short {
+		t.Errorf("short input: got %q, want %q", got, short)
+	}
+
+	long := strings.Repeat("x", 100)
+	got := ReadSnippet(strings.NewReader(long), 10)
+	if !strings.HasPrefix(got, strings.Repeat("x", 10)) || !strings.HasSuffix(got, "(truncated)") {
+		t.Errorf("long input: got %q, want a 10-byte prefix with a truncation marker", got)
+	}
+}
@@ -0,0 +1,146 @@
+package utils
+
+// cyrillicTable maps each Cyrillic letter used in Russian to its Latin
+// transliteration, preserving case.
+var cyrillicTable = map[rune]string{
+	'а': "a", 'А': "A",
+	'б': "b", 'Б': "B",
+	'в': "v", 'В': "V",
+	'г': "g", 'Г': "G",
+	'д': "d", 'Д': "D",
+	'е': "e", 'Е': "E",
+	'ё': "yo", 'Ё': "Yo",
+	'ж': "zh", 'Ж': "Zh",
+	'з': "z", 'З': "Z",
+	'и': "i", 'И': "I",
+	'й': "y", 'Й': "Y",
+	'к': "k", 'К': "K",
+	'л': "l", 'Л': "L",
+	'м': "m", 'М': "M",
+	'н': "n", 'Н': "N",
+	'о': "o", 'О': "O",
+	'п': "p", 'П': "P",
+	'р': "r", 'Р': "R",
+	'с': "s", 'С': "S",
+	'т': "t", 'Т': "T",
+	'у': "u", 'У': "U",
+	'ф': "f", 'Ф': "F",
+	'х': "kh", 'Х': "Kh",
+	'ц': "ts", 'Ц': "Ts",
+	'ч': "ch", 'Ч': "Ch",
+	'ш': "sh", 'Ш': "Sh",
+	'щ': "shch", 'Щ': "Shch",
+	'ъ': "", 'Ъ': "",
+	'ы': "y", 'Ы': "Y",
+	'ь': "", 'Ь': "",
+	'э': "e", 'Э': "E",
+	'ю': "yu", 'Ю': "Yu",
+	'я': "ya", 'Я': "Ya",
+}
+
+// kanaTable maps the basic (gojuon) hiragana and katakana syllables to
+// their romaji reading. It doesn't cover dakuten/handakuten or combined
+// (youon) syllables - a title built only from these base syllables still
+// round-trips cleanly, one more Japanese script than a title mixing in
+// Kanji, which this table has no way to romanize.
+var kanaTable = map[rune]string{
+	'あ': "a", 'い': "i", 'う': "u", 'え': "e", 'お': "o",
+	'か': "ka", 'き': "ki", 'く': "ku", 'け': "ke", 'こ': "ko",
+	'さ': "sa", 'し': "shi", 'す': "su", 'せ': "se", 'そ': "so",
+	'た': "ta", 'ち': "chi", 'つ': "tsu", 'て': "te", 'と': "to",
+	'な': "na", 'に': "ni", 'ぬ': "nu", 'ね': "ne", 'の': "no",
+	'は': "ha", 'ひ': "hi", 'ふ': "fu", 'へ': "he", 'ほ': "ho",
+	'ま': "ma", 'み': "mi", 'む': "mu", 'め': "me", 'も': "mo",
+	'や': "ya", 'ゆ': "yu", 'よ': "yo",
+	'ら': "ra", 'り': "ri", 'る': "ru", 'れ': "re", 'ろ': "ro",
+	'わ': "wa", 'を': "wo", 'ん': "n",
+	'ア': "a", 'イ': "i", 'ウ': "u", 'エ': "e", 'オ': "o",
+	'カ': "ka", 'キ': "ki", 'ク': "ku", 'ケ': "ke", 'コ': "ko",
+	'サ': "sa", 'シ': "shi", 'ス': "su", 'セ': "se", 'ソ': "so",
+	'タ': "ta", 'チ': "chi", 'ツ': "tsu", 'テ': "te", 'ト': "to",
+	'ナ': "na", 'ニ': "ni", 'ヌ': "nu", 'ネ': "ne", 'ノ': "no",
+	'ハ': "ha", 'ヒ': "hi", 'フ': "fu", 'ヘ': "he", 'ホ': "ho",
+	'マ': "ma", 'ミ': "mi", 'ム': "mu", 'メ': "me", 'モ': "mo",
+	'ヤ': "ya", 'ユ': "yu", 'ヨ': "yo",
+	'ラ': "ra", 'リ': "ri", 'ル': "ru", 'レ': "re", 'ロ': "ro",
+	'ワ': "wa", 'ヲ': "wo", 'ン': "n",
+}
+
+// devanagariConsonants maps each base consonant to its Latin syllable
+// including the inherent "a" vowel every Devanagari consonant carries
+// until a matra (vowel sign) or virama says otherwise.
+var devanagariConsonants = map[rune]string{
+	'क': "ka", 'ख': "kha", 'ग': "ga", 'घ': "gha", 'ङ': "nga",
+	'च': "cha", 'छ': "chha", 'ज': "ja", 'झ': "jha", 'ञ': "nya",
+	'ट': "ta", 'ठ': "tha", 'ड': "da", 'ढ': "dha", 'ण': "na",
+	'त': "ta", 'थ': "tha", 'द': "da", 'ध': "dha", 'न': "na",
+	'प': "pa", 'फ': "pha", 'ब': "ba", 'भ': "bha", 'म': "ma",
+	'य': "ya", 'र': "ra", 'ल': "la", 'व': "va",
+	'श': "sha", 'ष': "sha", 'स': "sa", 'ह': "ha",
+}
+
+// devanagariMatras maps each dependent vowel sign to the sound it replaces
+// a consonant's inherent "a" with.
+var devanagariMatras = map[rune]string{
+	'ा': "aa", 'ि': "i", 'ी': "ee", 'ु': "u", 'ू': "oo",
+	'े': "e", 'ै': "ai", 'ो': "o", 'ौ': "au",
+	'ं': "n", 'ः': "h",
+}
+
+// devanagariIndependentVowels maps a vowel used on its own (word-initial,
+// or anywhere not modifying a preceding consonant) to its Latin reading.
+var devanagariIndependentVowels = map[rune]string{
+	'अ': "a", 'आ': "aa", 'इ': "i", 'ई': "ee", 'उ': "u", 'ऊ': "oo",
+	'ए': "e", 'ऐ': "ai", 'ओ': "o", 'औ': "au",
+}
+
+// devanagariVirama (halant) cancels the inherent "a" of the consonant it
+// follows, leaving a bare consonant sound.
+const devanagariVirama = '्'
+
+// Transliterate romanizes s, unidecode-style: Cyrillic, kana, and
+// Devanagari characters are replaced with their closest Latin reading,
+// and ASCII passes through untouched. A Devanagari consonant's inherent
+// "a" is tracked as it's written, so a following matra or virama can
+// cancel or replace it instead of every consonant always reading "...a".
+// Any other non-ASCII rune - including Kanji and Hangul, which this
+// isn't a full unidecode port of - is dropped rather than passed through,
+// so the result is always safe to use as a filename.
+func Transliterate(s string) string {
+	out := make([]byte, 0, len(s))
+	pendingInherentA := false
+
+	for _, r := range s {
+		if r < 128 {
+			out = append(out, byte(r))
+			pendingInherentA = false
+		} else if repl, ok := cyrillicTable[r]; ok {
+			out = append(out, repl...)
+			pendingInherentA = false
+		} else if repl, ok := kanaTable[r]; ok {
+			out = append(out, repl...)
+			pendingInherentA = false
+		} else if syllable, ok := devanagariConsonants[r]; ok {
+			out = append(out, syllable...)
+			pendingInherentA = true
+		} else if r == devanagariVirama {
+			if pendingInherentA {
+				out = out[:len(out)-1]
+			}
+			pendingInherentA = false
+		} else if sound, ok := devanagariMatras[r]; ok {
+			if pendingInherentA {
+				out = out[:len(out)-1]
+			}
+			out = append(out, sound...)
+			pendingInherentA = false
+		} else if sound, ok := devanagariIndependentVowels[r]; ok {
+			out = append(out, sound...)
+			pendingInherentA = false
+		} else {
+			pendingInherentA = false
+		}
+	}
+
+	return string(out)
+}
@@ -0,0 +1,33 @@
+package utils
+
+import "testing"
+
+func TestTransliterateRussian(t *testing.T) {
+	if got := Transliterate("Привет"); got != "Privet" {
+		t.Errorf("Transliterate(%q) = %q, want %q", "Привет", got, "Privet")
+	}
+}
+
+func TestTransliterateJapanese(t *testing.T) {
+	if got := Transliterate("さくら"); got != "sakura" {
+		t.Errorf("Transliterate(%q) = %q, want %q", "さくら", got, "sakura")
+	}
+}
+
+func TestTransliterateHindi(t *testing.T) {
+	if got := Transliterate("नमस्ते"); got != "namaste" {
+		t.Errorf("Transliterate(%q) = %q, want %q", "नमस्ते", got, "namaste")
+	}
+}
+
+func TestTransliterateLeavesASCIIAlone(t *testing.T) {
+	if got := Transliterate("Dune 2"); got != "Dune 2" {
+		t.Errorf("Transliterate(%q) = %q, want it unchanged", "Dune 2", got)
+	}
+}
+
+func TestTransliterateDropsUnmappedScripts(t *testing.T) {
+	if got := Transliterate("図書館"); got != "" {
+		t.Errorf("Transliterate(%q) = %q, want empty for unmapped Kanji", "図書館", got)
+	}
+}
@@ -0,0 +1,51 @@
+package zlibrary
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrLoginRequired is returned by any account-gated action (sending to an
+// e-reader, saving a book, and so on) when the client has no authenticated
+// session. No login flow exists in this client yet, so today every one of
+// these actions always returns it.
+var ErrLoginRequired = errors.New("zlibrary: login required")
+
+// ErrSendNotConfigured is returned by SendToEmail when the account has no
+// send-to-email/Kindle address configured on the site.
+var ErrSendNotConfigured = errors.New("zlibrary: send-to-email is not configured on this account")
+
+// ErrSendLimitReached is returned by SendToEmail once the account's daily
+// send limit has already been used up.
+var ErrSendLimitReached = errors.New("zlibrary: daily send-to-email limit reached")
+
+// SendToEmail asks Z-Library to email bookID in format to the account's
+// configured send-to-email/Kindle address - the CSRF token for the send
+// form lives on the book's own page, alongside the account's configured
+// address. It requires an authenticated session, which this client does
+// not support yet, so it always returns ErrLoginRequired for now; the
+// typed errors above are already in place for when login support lands.
+func (c *Client) SendToEmail(bookID, format string) error {
+	return ErrLoginRequired
+}
+
+// SaveToAccount adds bookID to the account's personal saved-books list on
+// the site. It requires an authenticated session, which this client does
+// not support yet, so it always returns ErrLoginRequired for now.
+func (c *Client) SaveToAccount(bookID string) error {
+	return ErrLoginRequired
+}
+
+// RemoveFromAccount removes bookID from the account's personal saved-books
+// list. Like SaveToAccount, it always returns ErrLoginRequired until login
+// support exists.
+func (c *Client) RemoveFromAccount(bookID string) error {
+	return ErrLoginRequired
+}
+
+// GetSavedBooks reads back a page of the account's personal saved-books
+// list. Like SaveToAccount, it always returns ErrLoginRequired until login
+// support exists.
+func (c *Client) GetSavedBooks(ctx context.Context, page int) ([]BookSearchResult, error) {
+	return nil, ErrLoginRequired
+}
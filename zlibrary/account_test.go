@@ -0,0 +1,31 @@
+package zlibrary
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSendToEmailRequiresLogin(t *testing.T) {
+	c := NewClient()
+	if err := c.SendToEmail("12345", "epub"); !errors.Is(err, ErrLoginRequired) {
+		t.Errorf("SendToEmail() = %v, want ErrLoginRequired", err)
+	}
+}
+
+func TestSaveAndRemoveFromAccountRequireLogin(t *testing.T) {
+	c := NewClient()
+	if err := c.SaveToAccount("12345"); !errors.Is(err, ErrLoginRequired) {
+		t.Errorf("SaveToAccount() = %v, want ErrLoginRequired", err)
+	}
+	if err := c.RemoveFromAccount("12345"); !errors.Is(err, ErrLoginRequired) {
+		t.Errorf("RemoveFromAccount() = %v, want ErrLoginRequired", err)
+	}
+}
+
+func TestGetSavedBooksRequiresLogin(t *testing.T) {
+	c := NewClient()
+	if _, err := c.GetSavedBooks(context.Background(), 1); !errors.Is(err, ErrLoginRequired) {
+		t.Errorf("GetSavedBooks() = %v, want ErrLoginRequired", err)
+	}
+}
@@ -0,0 +1,54 @@
+package zlibrary
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/Advik-B/The-Blackbook-Archive/utils"
+)
+
+// parseAuthors reads every author credited in container - a search
+// result's or a details page's ".authors" block - into one Author per
+// anchor, resolving each anchor's href into an absolute author-page URL.
+// Some mirrors credit authors as plain text with no anchors at all; in
+// that case the block's text is treated as a single multi-author field and
+// split the same way SplitAuthors already does for legacy Author-only
+// callers, with every resulting Author left with a nil URL.
+func (c *Client) parseAuthors(container *goquery.Selection) []Author {
+	links := container.Find("a")
+	if links.Length() == 0 {
+		var authors []Author
+		for _, name := range utils.SplitAuthors(container.Text()) {
+			authors = append(authors, Author{Name: name})
+		}
+		return authors
+	}
+
+	var authors []Author
+	links.Each(func(_ int, a *goquery.Selection) {
+		name := utils.NormalizeAuthor(a.Text())
+		if name == "" {
+			return
+		}
+		author := Author{Name: name}
+		if href, ok := a.Attr("href"); ok && href != "" {
+			url := c.resolveURL(href)
+			author.URL = &url
+		}
+		authors = append(authors, author)
+	})
+	return authors
+}
+
+// joinAuthors renders Authors back into the classic semicolon-separated
+// Author string, so existing callers that work from the joined field -
+// filename templates, BibTeX and Markdown export, grouping keys - keep
+// working unchanged.
+func joinAuthors(authors []Author) string {
+	names := make([]string, len(authors))
+	for i, author := range authors {
+		names[i] = author.Name
+	}
+	return strings.Join(names, "; ")
+}
@@ -0,0 +1,90 @@
+package zlibrary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGetBookDetailsCollectsMultipleAuthorAnchors(t *testing.T) {
+	page := `<html><body>
+		<h1 itemprop="name">Dune: The Butlerian Jihad</h1>
+		<div class="authors">
+			<a href="/author/brian-herbert">Brian Herbert</a>,
+			<a href="/author/kevin-j-anderson">Anderson, Kevin J.</a>
+		</div>
+	</body></html>`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(page))
+	}))
+	defer server.Close()
+
+	c := NewClient()
+	c.SetBaseURL(server.URL)
+
+	d, err := c.GetBookDetails(context.Background(), "/book/789")
+	if err != nil {
+		t.Fatalf("GetBookDetails: %v", err)
+	}
+
+	if len(d.Authors) != 2 {
+		t.Fatalf("got %d authors, want 2: %+v", len(d.Authors), d.Authors)
+	}
+	if d.Authors[0].Name != "Brian Herbert" || d.Authors[0].URL == nil || !strings.HasSuffix(*d.Authors[0].URL, "/author/brian-herbert") {
+		t.Errorf("Authors[0] = %+v", d.Authors[0])
+	}
+	if d.Authors[1].Name != "Kevin J. Anderson" || d.Authors[1].URL == nil || !strings.HasSuffix(*d.Authors[1].URL, "/author/kevin-j-anderson") {
+		t.Errorf("Authors[1] = %+v, want the \"Last, First\" anchor text normalized", d.Authors[1])
+	}
+	if d.Author != "Brian Herbert; Kevin J. Anderson" {
+		t.Errorf("Author = %q, want the anchors joined with \"; \"", d.Author)
+	}
+}
+
+func TestParseSearchItemCollectsMultipleAuthorAnchors(t *testing.T) {
+	page := `<html><body><div class="book-item">
+		<a class="book-title" href="/book/1/dune.html">Dune</a>
+		<div class="authors"><a href="/author/frank-herbert">Frank Herbert</a></div>
+	</div></body></html>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(page))
+	if err != nil {
+		t.Fatalf("NewDocumentFromReader: %v", err)
+	}
+
+	c := NewClient()
+	result := c.parseSearchItem(doc.Find("div.book-item").First())
+
+	if len(result.Authors) != 1 || result.Authors[0].Name != "Frank Herbert" {
+		t.Errorf("Authors = %+v, want a single Frank Herbert entry", result.Authors)
+	}
+	if result.Authors[0].URL == nil || !strings.HasSuffix(*result.Authors[0].URL, "/author/frank-herbert") {
+		t.Errorf("Authors[0].URL = %v, want it resolved from the anchor's href", result.Authors[0].URL)
+	}
+	if result.Author != "Frank Herbert" {
+		t.Errorf("Author = %q, want %q", result.Author, "Frank Herbert")
+	}
+}
+
+func TestParseAuthorsFallsBackToPlainTextWhenNoAnchors(t *testing.T) {
+	page := `<html><body><div class="authors">Some Author</div></body></html>`
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(page))
+	if err != nil {
+		t.Fatalf("NewDocumentFromReader: %v", err)
+	}
+
+	c := NewClient()
+	authors := c.parseAuthors(doc.Find(".authors").First())
+
+	if len(authors) != 1 || authors[0].Name != "Some Author" {
+		t.Errorf("authors = %+v, want a single \"Some Author\" entry", authors)
+	}
+	if authors[0].URL != nil {
+		t.Errorf("authors[0].URL = %v, want nil for an anchor-less credit line", *authors[0].URL)
+	}
+}
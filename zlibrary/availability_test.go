@@ -0,0 +1,65 @@
+package zlibrary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetBookDetailsPremiumOnlyAvailability(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><body><div id="premiumOnlyNotice">Only available to premium members.</div></body></html>`))
+	}))
+	defer server.Close()
+
+	c := NewClient()
+	c.SetBaseURL(server.URL)
+
+	d, err := c.GetBookDetails(context.Background(), "/book/123")
+	if err != nil {
+		t.Fatalf("GetBookDetails: %v", err)
+	}
+	if d.Availability != AvailabilityPremiumOnly {
+		t.Errorf("Availability = %q, want %q", d.Availability, AvailabilityPremiumOnly)
+	}
+	if d.AvailabilityNotice != "Only available to premium members." {
+		t.Errorf("AvailabilityNotice = %q", d.AvailabilityNotice)
+	}
+}
+
+func TestGetBookDetailsRegionBlockedAvailability(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><body><div class="region-blocked">Not available in your region.</div></body></html>`))
+	}))
+	defer server.Close()
+
+	c := NewClient()
+	c.SetBaseURL(server.URL)
+
+	d, err := c.GetBookDetails(context.Background(), "/book/123")
+	if err != nil {
+		t.Fatalf("GetBookDetails: %v", err)
+	}
+	if d.Availability != AvailabilityRegionBlocked {
+		t.Errorf("Availability = %q, want %q", d.Availability, AvailabilityRegionBlocked)
+	}
+}
+
+func TestGetBookDetailsUnknownAvailabilityWithNoDownloadButton(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><body><h1 itemprop="name">A Book</h1></body></html>`))
+	}))
+	defer server.Close()
+
+	c := NewClient()
+	c.SetBaseURL(server.URL)
+
+	d, err := c.GetBookDetails(context.Background(), "/book/123")
+	if err != nil {
+		t.Fatalf("GetBookDetails: %v", err)
+	}
+	if d.Availability != AvailabilityUnknown {
+		t.Errorf("Availability = %q, want %q", d.Availability, AvailabilityUnknown)
+	}
+}
@@ -0,0 +1,71 @@
+package zlibrary
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// defaultBatchDelayMin and defaultBatchDelayMax bound the jittered pause
+// GetBookDetailsBatch (and any other multi-item operation that opts in)
+// inserts between successive requests, so a bulk operation's traffic looks
+// less like a tight, bot-like loop. This is distinct from the 429 backoff
+// in ratelimit.go, which only kicks in after the site has already pushed
+// back - this delay is paced proactively, before that ever happens.
+const (
+	defaultBatchDelayMin = 200 * time.Millisecond
+	defaultBatchDelayMax = 600 * time.Millisecond
+)
+
+// SetBatchDelay overrides the jittered inter-request delay multi-item
+// operations pace themselves with. Passing min == max == 0 disables the
+// delay entirely, for callers that want the old unpaced behavior (e.g.
+// tests hitting a local server).
+func (c *Client) SetBatchDelay(min, max time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.batchDelayMin = min
+	c.batchDelayMax = max
+	c.batchDelaySet = true
+}
+
+// batchDelayBounds returns the configured delay bounds, or the defaults if
+// SetBatchDelay was never called.
+func (c *Client) batchDelayBounds() (min, max time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.batchDelaySet {
+		return defaultBatchDelayMin, defaultBatchDelayMax
+	}
+	return c.batchDelayMin, c.batchDelayMax
+}
+
+// sleepBatchDelay pauses for a random duration within the configured batch
+// delay bounds, returning early with ctx.Err() if ctx is done first.
+func (c *Client) sleepBatchDelay(ctx context.Context) error {
+	min, max := c.batchDelayBounds()
+	if max <= 0 {
+		return nil
+	}
+	if max < min {
+		max = min
+	}
+
+	wait := min
+	if max > min {
+		wait += time.Duration(rand.Int63n(int64(max - min)))
+	}
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
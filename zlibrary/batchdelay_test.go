@@ -0,0 +1,32 @@
+package zlibrary
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSleepBatchDelayRespectsContextCancellation(t *testing.T) {
+	c := NewClient()
+	c.SetBatchDelay(time.Hour, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := c.sleepBatchDelay(ctx); err == nil {
+		t.Fatal("sleepBatchDelay() = nil, want context.Canceled")
+	}
+}
+
+func TestSleepBatchDelayDisabledWhenZero(t *testing.T) {
+	c := NewClient()
+	c.SetBatchDelay(0, 0)
+
+	start := time.Now()
+	if err := c.sleepBatchDelay(context.Background()); err != nil {
+		t.Fatalf("sleepBatchDelay() = %v, want nil", err)
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("sleepBatchDelay() took %s, want effectively instant when disabled", elapsed)
+	}
+}
@@ -0,0 +1,51 @@
+package zlibrary
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Advik-B/The-Blackbook-Archive/utils"
+)
+
+// BibTeX renders details as a @book BibTeX entry, suitable for pasting
+// straight into a .bib file. A multi-author credit renders as BibTeX
+// expects - each author joined by " and " - rather than however the
+// source mirror happened to separate them.
+func (d *BookDetails) BibTeX() string {
+	authors := utils.SplitAuthors(d.Author)
+	key := bibtexKey(authors, d.Year)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "@book{%s,\n", key)
+	fmt.Fprintf(&b, "  title = {%s},\n", d.Title)
+	if len(authors) > 0 {
+		fmt.Fprintf(&b, "  author = {%s},\n", strings.Join(authors, " and "))
+	}
+	if d.Publisher != "" {
+		fmt.Fprintf(&b, "  publisher = {%s},\n", d.Publisher)
+	}
+	if d.Year != "" {
+		fmt.Fprintf(&b, "  year = {%s},\n", d.Year)
+	}
+	if d.ISBN13 != "" {
+		fmt.Fprintf(&b, "  isbn = {%s},\n", d.ISBN13)
+	} else if d.ISBN10 != "" {
+		fmt.Fprintf(&b, "  isbn = {%s},\n", d.ISBN10)
+	}
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// bibtexKey builds a short citation key like "smith2020" from the first
+// author's last name and the year, falling back to "book" when either is
+// missing.
+func bibtexKey(authors []string, year string) string {
+	lastName := "book"
+	if len(authors) > 0 {
+		if fields := strings.Fields(authors[0]); len(fields) > 0 {
+			lastName = strings.ToLower(fields[len(fields)-1])
+		}
+	}
+	return lastName + year
+}
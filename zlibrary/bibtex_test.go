@@ -0,0 +1,48 @@
+package zlibrary
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBibTeXSingleAuthor(t *testing.T) {
+	d := &BookDetails{
+		BookSearchResult: BookSearchResult{Title: "Dune", Author: "Frank Herbert", Year: "1965"},
+	}
+
+	out := d.BibTeX()
+	if !strings.HasPrefix(out, "@book{herbert1965,\n") {
+		t.Errorf("BibTeX() = %q, want it to start with the herbert1965 key", out)
+	}
+	if !strings.Contains(out, "author = {Frank Herbert},") {
+		t.Errorf("BibTeX() = %q, want an author field", out)
+	}
+}
+
+func TestBibTeXMultipleAuthors(t *testing.T) {
+	d := &BookDetails{
+		BookSearchResult: BookSearchResult{Title: "Dune", Author: "Herbert, Brian; Anderson, Kevin J.", Year: "2009"},
+	}
+
+	out := d.BibTeX()
+	if !strings.HasPrefix(out, "@book{herbert2009,\n") {
+		t.Errorf("BibTeX() = %q, want the key derived from the first author", out)
+	}
+	if !strings.Contains(out, "author = {Brian Herbert and Kevin J. Anderson},") {
+		t.Errorf("BibTeX() = %q, want both authors joined with \"and\"", out)
+	}
+}
+
+func TestBibTeXNoAuthorFallsBackToBookKey(t *testing.T) {
+	d := &BookDetails{
+		BookSearchResult: BookSearchResult{Title: "Anonymous", Year: "1900"},
+	}
+
+	out := d.BibTeX()
+	if !strings.HasPrefix(out, "@book{book1900,\n") {
+		t.Errorf("BibTeX() = %q, want the \"book\" fallback key", out)
+	}
+	if strings.Contains(out, "author =") {
+		t.Errorf("BibTeX() = %q, want no author field", out)
+	}
+}
@@ -0,0 +1,76 @@
+package zlibrary
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// bookURLPathRe matches a book page's path, e.g. "/book/12345/abcdef".
+var bookURLPathRe = regexp.MustCompile(`/book/\d+`)
+
+// bookIDRe captures just the numeric ID out of a book page's path.
+var bookIDRe = regexp.MustCompile(`/book/(\d+)`)
+
+// bareBookIDRe matches input that's nothing but a book ID, with no URL
+// structure around it at all.
+var bareBookIDRe = regexp.MustCompile(`^\d+$`)
+
+// shareScheme prefixes the canonical, mirror-independent form of a book
+// link: "zlib://book/<id>". Unlike a mirror's own URL, this survives the
+// site's domain rotating, since NormalizeBookURL resolves it against
+// whichever mirror the client is currently configured to talk to.
+const shareScheme = "zlib://book/"
+
+// NormalizeBookURL validates that rawURL points at a book page on this
+// client's configured mirror - matching the /book/<id>/ path pattern once
+// resolved, and resolving to the same host the client is configured to talk
+// to - and returns that resolved URL. rawURL may also be a bare numeric book
+// ID (e.g. "12345"), in which case it's expanded to that book's path first.
+// It's meant for input a user pastes in directly (a shared link, or just an
+// ID) rather than one found by search, so it's checked before being used as
+// a details request. A URL on a different host is rejected outright rather
+// than attempted, since resolving it would otherwise silently follow
+// whatever absolute URL the user pasted in.
+func (c *Client) NormalizeBookURL(rawURL string) (string, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	switch {
+	case strings.HasPrefix(rawURL, shareScheme):
+		rawURL = "/book/" + strings.TrimPrefix(rawURL, shareScheme)
+	case bareBookIDRe.MatchString(rawURL):
+		rawURL = "/book/" + rawURL
+	}
+
+	resolved := c.resolveURL(rawURL)
+	if resolved == "" || !bookURLPathRe.MatchString(resolved) {
+		return "", fmt.Errorf("zlibrary: %q does not look like a book page URL or ID", rawURL)
+	}
+
+	resolvedHost, baseHost := urlHost(resolved), urlHost(c.BaseURL())
+	if baseHost != "" && resolvedHost != baseHost {
+		return "", fmt.Errorf("zlibrary: %q is not on the configured mirror (%s)", rawURL, baseHost)
+	}
+
+	return resolved, nil
+}
+
+// ShareLink returns the canonical "zlib://book/<id>" form of detailsURL
+// alongside its current fully-resolved URL on this client's mirror, for a
+// "copy share link" action that should survive the mirror's domain
+// rotating. ok is false if detailsURL doesn't carry a recognizable book ID.
+func (c *Client) ShareLink(detailsURL string) (shareLink, fullURL string, ok bool) {
+	match := bookIDRe.FindStringSubmatch(detailsURL)
+	if match == nil {
+		return "", "", false
+	}
+	return shareScheme + match[1], c.resolveURL(detailsURL), true
+}
+
+func urlHost(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Host)
+}
@@ -0,0 +1,96 @@
+package zlibrary
+
+import "testing"
+
+func TestNormalizeBookURL(t *testing.T) {
+	c := NewClient()
+	c.SetBaseURL("https://z-lib.io")
+
+	cases := []struct {
+		in      string
+		wantErr bool
+	}{
+		{"/book/12345/abcdef", false},
+		{"https://z-lib.io/book/12345/abcdef", false},
+		{"https://z-lib.io/s/some-query", true},
+		{"not a url at all", true},
+		{"", true},
+		{"12345", false},
+		{"  12345  ", false},
+		{"https://evil-mirror.example.com/book/12345/abcdef", true},
+		{"zlib://book/12345", false},
+	}
+
+	for _, tc := range cases {
+		_, err := c.NormalizeBookURL(tc.in)
+		if tc.wantErr && err == nil {
+			t.Errorf("NormalizeBookURL(%q) = nil error, want one", tc.in)
+		}
+		if !tc.wantErr && err != nil {
+			t.Errorf("NormalizeBookURL(%q) = %v, want no error", tc.in, err)
+		}
+	}
+}
+
+func TestNormalizeBookURLStripsTrackingParamsInSafeMode(t *testing.T) {
+	c := NewClient()
+	c.SetBaseURL("https://z-lib.io")
+	c.SetSafeMode(true)
+
+	got, err := c.NormalizeBookURL("https://z-lib.io/book/12345/abcdef?utm_source=friend")
+	if err != nil {
+		t.Fatalf("NormalizeBookURL: %v", err)
+	}
+	if got != "https://z-lib.io/book/12345/abcdef" {
+		t.Errorf("NormalizeBookURL() = %q, want tracking param stripped", got)
+	}
+}
+
+func TestShareLink(t *testing.T) {
+	c := NewClient()
+	c.SetBaseURL("https://z-lib.io")
+
+	shareLink, fullURL, ok := c.ShareLink("/book/12345/abcdef-slug")
+	if !ok {
+		t.Fatalf("ShareLink() ok = false, want true")
+	}
+	if shareLink != "zlib://book/12345" {
+		t.Errorf("shareLink = %q, want %q", shareLink, "zlib://book/12345")
+	}
+	if fullURL != "https://z-lib.io/book/12345/abcdef-slug" {
+		t.Errorf("fullURL = %q", fullURL)
+	}
+
+	if _, _, ok := c.ShareLink("/s/some-query"); ok {
+		t.Error("ShareLink() ok = true for a non-book URL, want false")
+	}
+}
+
+func TestNormalizeBookURLRoundTripsShareLink(t *testing.T) {
+	c := NewClient()
+	c.SetBaseURL("https://z-lib.io")
+
+	shareLink, _, ok := c.ShareLink("/book/12345/abcdef-slug")
+	if !ok {
+		t.Fatalf("ShareLink() ok = false, want true")
+	}
+
+	got, err := c.NormalizeBookURL(shareLink)
+	if err != nil {
+		t.Fatalf("NormalizeBookURL(%q): %v", shareLink, err)
+	}
+	if got != "https://z-lib.io/book/12345" {
+		t.Errorf("NormalizeBookURL(%q) = %q, want %q", shareLink, got, "https://z-lib.io/book/12345")
+	}
+}
+
+func TestNormalizeBookURLWithSlugAndWithout(t *testing.T) {
+	c := NewClient()
+	c.SetBaseURL("https://z-lib.io")
+
+	for _, in := range []string{"/book/12345/abcdef-slug", "/book/12345"} {
+		if _, err := c.NormalizeBookURL(in); err != nil {
+			t.Errorf("NormalizeBookURL(%q) = %v, want no error", in, err)
+		}
+	}
+}
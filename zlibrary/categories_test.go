@@ -0,0 +1,44 @@
+package zlibrary
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestParseCategoriesBuildsParentChain(t *testing.T) {
+	html := `<div class="book-property__categories">
+		<a href="/category/fiction">Fiction</a>
+		<a href="/category/fiction/scifi">Science Fiction</a>
+	</div>`
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+
+	c := &Client{baseURL: "https://example.com"}
+	categories := c.parseCategories(doc)
+
+	if len(categories) != 2 {
+		t.Fatalf("got %d categories, want 2", len(categories))
+	}
+	if categories[0].Name != "Fiction" || categories[0].Parent != nil {
+		t.Errorf("categories[0] = %+v, want top-level Fiction", categories[0])
+	}
+	if categories[1].Name != "Science Fiction" || categories[1].Parent == nil || *categories[1].Parent != "Fiction" {
+		t.Errorf("categories[1] = %+v, want child of Fiction", categories[1])
+	}
+}
+
+func TestParseCategoriesEmptyWhenNoMarkup(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html></html>"))
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+
+	c := &Client{baseURL: "https://example.com"}
+	if categories := c.parseCategories(doc); len(categories) != 0 {
+		t.Errorf("parseCategories() = %v, want empty", categories)
+	}
+}
@@ -0,0 +1,287 @@
+// Package zlibrary implements a scraping client for Z-Library's web
+// interface: searching, reading book detail pages, and resolving download
+// links. It deliberately speaks only HTML/HTTP, since Z-Library exposes no
+// stable public API.
+package zlibrary
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultBaseURL   = "https://z-lib.io"
+	defaultUserAgent = "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0 Safari/537.36"
+)
+
+// Client is a Z-Library scraping client. It is safe for concurrent use.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+
+	mu               sync.Mutex
+	userAgent        string
+	secChUA          string
+	secChUAPlatform  string
+	lastReferrer     string
+	sendReferer      bool
+	safeMode         bool
+	ipfsGateways     []string
+	preferredFormats []string
+
+	// debugSnapshotDir, when set, makes fetchDocument save the full body of
+	// any failed request here for later inspection. See SetDebugSnapshotDir.
+	debugSnapshotDir string
+
+	// httpDumpDir, when set, makes do save every request/response exchange
+	// here, not just failed ones. See SetHTTPDump.
+	httpDumpDir string
+
+	// onRateLimited, when set, is called before do() waits out a 429's
+	// Retry-After. See SetRateLimitCallback.
+	onRateLimited func(wait time.Duration)
+
+	// searchParseProgress, when set, is called while a search results page
+	// is being parsed. See SetSearchParseProgressCallback.
+	searchParseProgress func(parsed, total int)
+
+	// batchDelayMin/batchDelayMax and batchDelaySet back SetBatchDelay; see
+	// batchdelay.go.
+	batchDelayMin time.Duration
+	batchDelayMax time.Duration
+	batchDelaySet bool
+
+	// mirrorRacingEnabled, mirrorCandidates, and mirrorRaceStagger back
+	// SetMirrorRacing/SetMirrorCandidates/SetMirrorRaceStagger; see
+	// mirrorrace.go.
+	mirrorRacingEnabled bool
+	mirrorCandidates    []string
+	mirrorRaceStagger   time.Duration
+
+	// language backs SetLanguage; see language.go.
+	language string
+
+	// connectTimeout and responseHeaderTimeout back SetConnectTimeout and
+	// SetResponseHeaderTimeout; see timeouts.go. The overall timeout lives
+	// on httpClient.Timeout itself rather than a separate field, since
+	// net/http already tracks it there.
+	connectTimeout        time.Duration
+	responseHeaderTimeout time.Duration
+
+	// requestHook and responseHook back SetRequestHook/SetResponseHook; see
+	// hooks.go.
+	requestHook  RequestHook
+	responseHook ResponseHook
+
+	detailsCalls callGroup
+}
+
+// NewClient returns a Client configured with sane defaults, ready to search
+// and fetch book details against the default Z-Library mirror. The initial
+// language is taken from the OS locale, falling back to English.
+func NewClient() *Client {
+	return &Client{
+		httpClient: &http.Client{
+			Timeout:   defaultOverallTimeout,
+			Transport: newTransport(defaultConnectTimeout, defaultResponseHeaderTimeout),
+		},
+		baseURL:     defaultBaseURL,
+		userAgent:   defaultUserAgent,
+		sendReferer: true,
+		language:    osLanguage(),
+	}
+}
+
+// SetSendReferer controls whether MakeRequest attaches a Referer header at
+// all. It defaults to true to preserve existing behavior. Some mirrors sit
+// behind strict proxies that reject any request carrying a Referer from a
+// different origin, so this gives users full control over that behavior.
+func (c *Client) SetSendReferer(send bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sendReferer = send
+}
+
+// SetUserAgent overrides the User-Agent sent with every request, clearing
+// any client-hint headers set by a previous EnableUserAgentRotation call
+// since they'd no longer match.
+func (c *Client) SetUserAgent(userAgent string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.userAgent = userAgent
+	c.secChUA = ""
+	c.secChUAPlatform = ""
+}
+
+// UserAgent returns the User-Agent currently in use.
+func (c *Client) UserAgent() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.userAgent
+}
+
+// EnableUserAgentRotation picks one browser profile at random from a small
+// built-in pool and uses it - along with its matching sec-ch-ua client
+// hints - for the lifetime of this Client. Rotation happens once per
+// session rather than per request, since a User-Agent that changes
+// mid-session is itself suspicious.
+func (c *Client) EnableUserAgentRotation() {
+	profile := pickUserAgentProfile()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.userAgent = profile.userAgent
+	c.secChUA = profile.secChUA
+	c.secChUAPlatform = profile.secChUAPlatform
+}
+
+// SetSafeMode controls whether resolveURL strips known tracking/session
+// query parameters (see stripTrackingParams) from every cover, download,
+// and author URL it resolves. Off by default to preserve existing
+// behavior.
+func (c *Client) SetSafeMode(enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.safeMode = enabled
+}
+
+// SetBaseURL overrides the mirror this client talks to.
+func (c *Client) SetBaseURL(baseURL string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.baseURL = baseURL
+}
+
+// BaseURL returns the mirror this client currently talks to.
+func (c *Client) BaseURL() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.baseURL
+}
+
+// MakeRequest issues an HTTP request with the client's standard headers,
+// carrying the previous request's URL as the Referer. The Referer is
+// updated to this request's URL once it succeeds.
+func (c *Client) MakeRequest(ctx context.Context, method, url string, body io.Reader) (*http.Response, error) {
+	req, err := c.newRequest(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if c.sendReferer && c.lastReferrer != "" {
+		req.Header.Set("Referer", c.lastReferrer)
+	}
+	c.mu.Unlock()
+
+	return c.do(req)
+}
+
+// MakeRequestWithReferer is like MakeRequest, but sends referer explicitly
+// instead of the last request's URL. Some endpoints - notably a book's
+// download link - check that the Referer is the book's own page and serve
+// a limit/error page otherwise, which by the time a user clicks Download
+// the tracked lastReferrer often no longer is.
+func (c *Client) MakeRequestWithReferer(ctx context.Context, method, url string, body io.Reader, referer string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if referer != "" {
+		req.Header.Set("Referer", referer)
+	}
+
+	return c.do(req)
+}
+
+// newRequest builds a request carrying every header that doesn't depend on
+// which Referer variant the caller wants.
+func (c *Client) newRequest(ctx context.Context, method, url string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("Accept-Encoding", "gzip, br")
+	if c.secChUA != "" {
+		req.Header.Set("sec-ch-ua", c.secChUA)
+		req.Header.Set("sec-ch-ua-platform", c.secChUAPlatform)
+		req.Header.Set("sec-ch-ua-mobile", "?0")
+	}
+	if c.language != "" {
+		req.Header.Set("Accept-Language", c.language)
+		req.AddCookie(&http.Cookie{Name: "language", Value: c.language})
+	}
+	c.mu.Unlock()
+
+	return req, nil
+}
+
+// do executes req, decodes its body per Content-Encoding, and records its
+// URL as the Referer for the next MakeRequest call. A single 429 response
+// is retried after honoring Retry-After - distinct from, and ahead of, any
+// generic error handling callers layer on top.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	resp, err := c.doHTTP(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		resp, err = c.retryAfterRateLimit(req, resp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := decodeBody(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	if dir := c.httpDumpDirLocked(); dir != "" {
+		resp, err = dumpHTTPExchange(dir, req, resp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c.mu.Lock()
+	c.lastReferrer = req.URL.String()
+	c.mu.Unlock()
+
+	return resp, nil
+}
+
+// retryAfterRateLimit waits out a 429's Retry-After (or a default backoff
+// if it didn't send one) and retries the request exactly once.
+func (c *Client) retryAfterRateLimit(req *http.Request, resp *http.Response) (*http.Response, error) {
+	wait, ok := retryAfterDuration(resp.Header.Get("Retry-After"))
+	resp.Body.Close()
+	if !ok {
+		wait = defaultRateLimitBackoff
+	}
+
+	c.notifyRateLimited(wait)
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	retry, err := retryRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doHTTP(retry)
+}
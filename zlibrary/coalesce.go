@@ -0,0 +1,48 @@
+package zlibrary
+
+import "sync"
+
+// callGroup coalesces concurrent calls that share the same key into a
+// single underlying call, so that fetching the same URL from several
+// goroutines at once - e.g. a details pane and a comparison fetch racing
+// on the same book - only hits the network once. All callers for a given
+// key receive the same result.
+type callGroup struct {
+	mu    sync.Mutex
+	calls map[string]*inflightCall
+}
+
+type inflightCall struct {
+	wg     sync.WaitGroup
+	result *BookDetails
+	err    error
+}
+
+// do runs fn for key if no call for key is already in flight, otherwise it
+// waits for that call to finish and returns its result.
+func (g *callGroup) do(key string, fn func() (*BookDetails, error)) (*BookDetails, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*inflightCall)
+	}
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		call.wg.Wait()
+		return call.result, call.err
+	}
+
+	call := &inflightCall{}
+	call.wg.Add(1)
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	call.result, call.err = fn()
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+
+	call.wg.Done()
+
+	return call.result, call.err
+}
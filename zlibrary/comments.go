@@ -0,0 +1,110 @@
+package zlibrary
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Comment is one user comment/review on a book's details page.
+type Comment struct {
+	Author string
+	Date   string
+	Text   string
+
+	// Rating is the star rating attached to this comment, if any. nil
+	// when the comment didn't carry one.
+	Rating *int
+}
+
+// GetBookComments fetches a page of comments for the book at bookURL.
+// Most mirrors render the first page of comments inline on the book page
+// itself; when that markup turns up nothing, or for any page after the
+// first, this falls back to the lazily-loaded XHR endpoint the page's own
+// "load more" button calls, keyed by the book's ID. Either way, a book with no comments yields an
+// empty slice rather than an error.
+func (c *Client) GetBookComments(ctx context.Context, bookURL string, page int) ([]Comment, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	doc, err := c.fetchDocument(ctx, http.MethodGet, c.resolveURL(bookURL), nil)
+	if err != nil {
+		return nil, fmt.Errorf("zlibrary: comments request: %w", err)
+	}
+
+	comments := parseComments(doc.Selection)
+	if len(comments) > 0 && page == 1 {
+		return comments, nil
+	}
+
+	bookID := bookIDFromURL(bookURL)
+	if bookID == "" {
+		return comments, nil
+	}
+
+	ajaxComments, err := c.getBookCommentsAjax(ctx, bookID, page)
+	if err != nil {
+		// The inline page is the source of truth; a broken ajax endpoint
+		// just means no comments beyond what the page already rendered,
+		// not a request failure.
+		return comments, nil
+	}
+	return ajaxComments, nil
+}
+
+func (c *Client) getBookCommentsAjax(ctx context.Context, bookID string, page int) ([]Comment, error) {
+	url := fmt.Sprintf("%s/comments/book/%s?page=%d", c.BaseURL(), bookID, page)
+	doc, err := c.fetchDocument(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseComments(doc.Selection), nil
+}
+
+func parseComments(root *goquery.Selection) []Comment {
+	var comments []Comment
+	root.Find(".comment, .book-comment").Each(func(_ int, item *goquery.Selection) {
+		text := flattenText(item.Find(".comment-text, .text").First())
+		if text == "" {
+			return
+		}
+
+		var rating *int
+		if ratingText, ok := item.Find(".comment-rating, .rating").First().Attr("data-rating"); ok {
+			if n, err := strconv.Atoi(strings.TrimSpace(ratingText)); err == nil {
+				rating = &n
+			}
+		}
+
+		comments = append(comments, Comment{
+			Author: strings.TrimSpace(item.Find(".comment-author, .author").First().Text()),
+			Date:   strings.TrimSpace(item.Find(".comment-date, .date").First().Text()),
+			Text:   text,
+			Rating: rating,
+		})
+	})
+	return comments
+}
+
+// flattenText returns sel's text content with HTML stripped, collapsing
+// whatever whitespace is left over from block-level tags like <br> or <p>
+// into single spaces, so a multi-paragraph comment comes out as one line.
+func flattenText(sel *goquery.Selection) string {
+	return strings.Join(strings.Fields(sel.Text()), " ")
+}
+
+var bookIDFromURLRe = regexp.MustCompile(`/book/(\d+)`)
+
+func bookIDFromURL(bookURL string) string {
+	match := bookIDFromURLRe.FindStringSubmatch(bookURL)
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
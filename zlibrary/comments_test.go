@@ -0,0 +1,63 @@
+package zlibrary
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestParseCommentsFlattensHTML(t *testing.T) {
+	html := `<div class="comment">
+		<span class="comment-author">Jo</span>
+		<span class="comment-date">2024-01-02</span>
+		<div class="comment-rating" data-rating="4"></div>
+		<div class="comment-text"><p>Great scan.</p><p>Missing a few pages though.</p></div>
+	</div>`
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+
+	comments := parseComments(doc.Selection)
+	if len(comments) != 1 {
+		t.Fatalf("got %d comments, want 1", len(comments))
+	}
+
+	c := comments[0]
+	if c.Author != "Jo" || c.Date != "2024-01-02" {
+		t.Errorf("comment = %+v, want author Jo / date 2024-01-02", c)
+	}
+	if c.Rating == nil || *c.Rating != 4 {
+		t.Errorf("rating = %v, want 4", c.Rating)
+	}
+	if strings.Contains(c.Text, "<") {
+		t.Errorf("Text still contains HTML: %q", c.Text)
+	}
+	if c.Text != "Great scan. Missing a few pages though." {
+		t.Errorf("Text = %q, want flattened paragraphs", c.Text)
+	}
+}
+
+func TestParseCommentsEmptyWhenNoMarkup(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html></html>"))
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	if comments := parseComments(doc.Selection); len(comments) != 0 {
+		t.Errorf("got %d comments, want 0", len(comments))
+	}
+}
+
+func TestBookIDFromURL(t *testing.T) {
+	cases := map[string]string{
+		"/book/12345/abcdef": "12345",
+		"https://z-lib.io/book/987/slug": "987",
+		"/s/some-query":      "",
+	}
+	for in, want := range cases {
+		if got := bookIDFromURL(in); got != want {
+			t.Errorf("bookIDFromURL(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
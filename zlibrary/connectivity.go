@@ -0,0 +1,60 @@
+package zlibrary
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Advik-B/The-Blackbook-Archive/utils"
+)
+
+// connectivityCheckTimeout bounds how long CheckConnectivity waits for the
+// base URL to respond, independent of whatever timeout ctx already
+// carries, so a hung mirror doesn't leave the UI's startup check spinning.
+const connectivityCheckTimeout = 10 * time.Second
+
+// CheckConnectivity does a lightweight GET against the client's current
+// base URL to tell whether it's reachable and not serving a block/challenge
+// page, without the cost of a full search. finalURL is where the request
+// landed after any redirects, useful for spotting a mirror that silently
+// bounces to a different domain.
+func (c *Client) CheckConnectivity(ctx context.Context) (ok bool, finalURL string, err error) {
+	ctx, cancel := context.WithTimeout(ctx, connectivityCheckTimeout)
+	defer cancel()
+
+	req, err := c.newRequest(ctx, http.MethodGet, c.BaseURL(), nil)
+	if err != nil {
+		return false, "", err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return false, "", fmt.Errorf("zlibrary: connectivity check: %w", err)
+	}
+	defer resp.Body.Close()
+
+	finalURL = resp.Request.URL.String()
+	snippet := strings.ToLower(utils.ReadSnippet(resp.Body, utils.DefaultSnippetBytes))
+
+	if isBlockedResponse(resp, snippet) {
+		return false, finalURL, nil
+	}
+	return resp.StatusCode < 400, finalURL, nil
+}
+
+// isBlockedResponse reports whether resp looks like a Cloudflare (or
+// similar) interstitial rather than the real site - the status code alone
+// isn't a reliable signal, since these often come back as 200 or 503
+// depending on the challenge type.
+func isBlockedResponse(resp *http.Response, bodySnippet string) bool {
+	server := strings.ToLower(resp.Header.Get("Server"))
+	if strings.Contains(server, "cloudflare") && resp.StatusCode >= 400 {
+		return true
+	}
+	if resp.Header.Get("cf-mitigated") != "" {
+		return true
+	}
+	return strings.Contains(bodySnippet, "checking your browser") || strings.Contains(bodySnippet, "cf-browser-verification")
+}
@@ -0,0 +1,49 @@
+package zlibrary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheckConnectivityOK(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<html><body>Welcome</body></html>"))
+	}))
+	defer server.Close()
+
+	c := NewClient()
+	c.SetBaseURL(server.URL)
+
+	ok, finalURL, err := c.CheckConnectivity(context.Background())
+	if err != nil {
+		t.Fatalf("CheckConnectivity: %v", err)
+	}
+	if !ok {
+		t.Errorf("ok = false, want true for a normal response")
+	}
+	if finalURL == "" {
+		t.Errorf("finalURL is empty")
+	}
+}
+
+func TestCheckConnectivityDetectsCloudflareChallenge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Server", "cloudflare")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("Checking your browser before accessing the site."))
+	}))
+	defer server.Close()
+
+	c := NewClient()
+	c.SetBaseURL(server.URL)
+
+	ok, _, err := c.CheckConnectivity(context.Background())
+	if err != nil {
+		t.Fatalf("CheckConnectivity: %v", err)
+	}
+	if ok {
+		t.Errorf("ok = true, want false for a Cloudflare challenge page")
+	}
+}
@@ -0,0 +1,52 @@
+package zlibrary
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// conversionPollInterval and conversionPollTimeout bound PollConversion's
+// polling loop: how often to check, and how long to keep trying before
+// giving up on a conversion that never finishes.
+const (
+	conversionPollInterval = 3 * time.Second
+	conversionPollTimeout  = 2 * time.Minute
+)
+
+// ConversionTicket identifies an in-progress server-side format
+// conversion requested via RequestConversion.
+type ConversionTicket struct {
+	BookID string
+	Format string
+}
+
+// ConversionStatus is the state of a server-side conversion requested via
+// RequestConversion.
+type ConversionStatus string
+
+const (
+	ConversionPending ConversionStatus = "pending"
+	ConversionReady   ConversionStatus = "ready"
+	ConversionFailed  ConversionStatus = "failed"
+)
+
+// ErrConversionLimitReached is returned by RequestConversion once the
+// account's daily conversion limit has already been used up.
+var ErrConversionLimitReached = errors.New("zlibrary: daily conversion limit reached")
+
+// RequestConversion asks the site to convert bookID to format, returning a
+// ticket PollConversion can check on. It requires an authenticated
+// session, which this client does not support yet, so it always returns
+// ErrLoginRequired for now.
+func (c *Client) RequestConversion(ctx context.Context, bookID, format string) (ConversionTicket, error) {
+	return ConversionTicket{}, ErrLoginRequired
+}
+
+// PollConversion checks ticket's status, polling at conversionPollInterval
+// until it resolves or conversionPollTimeout elapses. Like
+// RequestConversion, it always returns ErrLoginRequired until login
+// support exists - so there is nothing to poll yet.
+func (c *Client) PollConversion(ctx context.Context, ticket ConversionTicket) (ConversionStatus, string, error) {
+	return "", "", ErrLoginRequired
+}
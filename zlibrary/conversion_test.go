@@ -0,0 +1,20 @@
+package zlibrary
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRequestAndPollConversionRequireLogin(t *testing.T) {
+	c := NewClient()
+
+	ticket, err := c.RequestConversion(context.Background(), "12345", "epub")
+	if !errors.Is(err, ErrLoginRequired) {
+		t.Errorf("RequestConversion() = %v, want ErrLoginRequired", err)
+	}
+
+	if _, _, err := c.PollConversion(context.Background(), ticket); !errors.Is(err, ErrLoginRequired) {
+		t.Errorf("PollConversion() = %v, want ErrLoginRequired", err)
+	}
+}
@@ -0,0 +1,39 @@
+package zlibrary
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+)
+
+// SetDebugSnapshotDir turns on full-body snapshotting of failed requests to
+// dir, for diagnosing scrape breakage without exposing full response bodies
+// in ordinary error messages. An empty dir (the default) disables it.
+func (c *Client) SetDebugSnapshotDir(dir string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.debugSnapshotDir = dir
+}
+
+func (c *Client) snapshotDir() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.debugSnapshotDir
+}
+
+// writeDebugSnapshot best-effort writes data to a file named after a hash
+// of requestURL under dir. Failures are silently ignored - this is a
+// debugging aid, not something that should itself become a new error path.
+func writeDebugSnapshot(dir, requestURL string, data []byte) {
+	if dir == "" {
+		return
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return
+	}
+
+	sum := sha1.Sum([]byte(requestURL))
+	name := hex.EncodeToString(sum[:]) + ".html"
+	_ = os.WriteFile(filepath.Join(dir, name), data, 0o644)
+}
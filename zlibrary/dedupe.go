@@ -0,0 +1,91 @@
+package zlibrary
+
+import (
+	"strings"
+
+	"github.com/Advik-B/The-Blackbook-Archive/utils"
+)
+
+// EditionGroup collects every result that looks like the same underlying
+// book - grouped by source and book ID when available, or a normalized title+author
+// otherwise - so a UI can show one entry with its editions nested rather
+// than a flat list full of near-duplicates.
+type EditionGroup struct {
+	Key      string
+	Editions []BookSearchResult
+}
+
+// GroupEditions deduplicates and groups results into editions of the same
+// book. Input order is preserved for the first occurrence of each group.
+func GroupEditions(results []BookSearchResult) []EditionGroup {
+	groups := make(map[string]*EditionGroup)
+	var order []string
+
+	for _, r := range results {
+		key := editionKey(r)
+		group, ok := groups[key]
+		if !ok {
+			group = &EditionGroup{Key: key}
+			groups[key] = group
+			order = append(order, key)
+		}
+		group.Editions = append(group.Editions, r)
+	}
+
+	out := make([]EditionGroup, 0, len(order))
+	for _, key := range order {
+		out = append(out, *groups[key])
+	}
+	return out
+}
+
+// PreferredEdition returns which of the group's editions a caller should
+// show or act on by default: the first one seen, or - when preferSmaller
+// is true - the smallest by file size among editions whose size could be
+// parsed from SizeText. Falls back to the first edition when none of them
+// have a parseable size, so turning the preference on never leaves a group
+// without a default.
+func (g EditionGroup) PreferredEdition(preferSmaller bool) BookSearchResult {
+	if !preferSmaller || len(g.Editions) <= 1 {
+		return g.Editions[0]
+	}
+
+	best := g.Editions[0]
+	bestSize := parseSizeText(best.SizeText)
+	for _, e := range g.Editions[1:] {
+		size := parseSizeText(e.SizeText)
+		if size <= 0 {
+			continue
+		}
+		if bestSize <= 0 || size < bestSize {
+			best, bestSize = e, size
+		}
+	}
+	return best
+}
+
+// editionKey returns the grouping key for a result: its ID if known (most
+// specific), falling back to a normalized title+author. The author side
+// goes through utils.NormalizeAuthor first (via primaryAuthor) so a "Last,
+// First" scrape and a "First Last" scrape of the same author group
+// together instead of splitting into two apparent editions.
+func editionKey(r BookSearchResult) string {
+	if r.ID != "" {
+		return r.Source + ":" + r.ID
+	}
+	return normalizeKey(r.Title) + "|" + normalizeKey(primaryAuthor(r.Author))
+}
+
+// primaryAuthor returns the first author in a possibly multi-author
+// credit line, normalized, or "" if author doesn't name anyone.
+func primaryAuthor(author string) string {
+	authors := utils.SplitAuthors(author)
+	if len(authors) == 0 {
+		return ""
+	}
+	return authors[0]
+}
+
+func normalizeKey(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
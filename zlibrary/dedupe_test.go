@@ -0,0 +1,44 @@
+package zlibrary
+
+import "testing"
+
+func TestGroupEditionsGroupsInvertedAuthorNameWithNormalName(t *testing.T) {
+	results := []BookSearchResult{
+		{Title: "Dune", Author: "Herbert, Frank"},
+		{Title: "Dune", Author: "Frank Herbert"},
+	}
+
+	groups := GroupEditions(results)
+	if len(groups) != 1 {
+		t.Fatalf("GroupEditions() = %d group(s), want 1", len(groups))
+	}
+	if len(groups[0].Editions) != 2 {
+		t.Errorf("group has %d edition(s), want 2", len(groups[0].Editions))
+	}
+}
+
+func TestPreferredEditionPrefersSmallestWhenEnabled(t *testing.T) {
+	group := EditionGroup{Editions: []BookSearchResult{
+		{Title: "Dune", SizeText: "12 MB"},
+		{Title: "Dune", SizeText: "3 MB"},
+		{Title: "Dune", SizeText: "8 MB"},
+	}}
+
+	if got := group.PreferredEdition(false); got.SizeText != "12 MB" {
+		t.Errorf("PreferredEdition(false) = %q, want the first edition (12 MB)", got.SizeText)
+	}
+	if got := group.PreferredEdition(true); got.SizeText != "3 MB" {
+		t.Errorf("PreferredEdition(true) = %q, want the smallest edition (3 MB)", got.SizeText)
+	}
+}
+
+func TestPreferredEditionFallsBackWhenSizeUnknown(t *testing.T) {
+	group := EditionGroup{Editions: []BookSearchResult{
+		{Title: "Dune", SizeText: ""},
+		{Title: "Dune", SizeText: "not a size"},
+	}}
+
+	if got := group.PreferredEdition(true); got != group.Editions[0] {
+		t.Errorf("PreferredEdition(true) = %+v, want the first edition when no size is parseable", got)
+	}
+}
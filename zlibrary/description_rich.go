@@ -0,0 +1,81 @@
+package zlibrary
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// DescriptionRun is one contiguous span of text within a DescriptionBlock,
+// carrying whatever emphasis its source tag applied.
+type DescriptionRun struct {
+	Text   string `json:"text"`
+	Bold   bool   `json:"bold,omitempty"`
+	Italic bool   `json:"italic,omitempty"`
+}
+
+// DescriptionBlock is one paragraph or list item of a book's description,
+// broken into runs so bold/italic emphasis survives alongside the text.
+type DescriptionBlock struct {
+	ListItem bool             `json:"list_item,omitempty"`
+	Runs     []DescriptionRun `json:"runs,omitempty"`
+}
+
+// parseDescriptionRich turns a book's description markup into a light
+// intermediate form - one DescriptionBlock per paragraph or list item,
+// each carrying the bold/italic spans within it - so a renderer can show
+// structure the plain Description field collapses. Any tag this doesn't
+// recognize (links, spans, line breaks, ...) degrades to its plain text
+// rather than being dropped.
+func parseDescriptionRich(doc *goquery.Document) []DescriptionBlock {
+	container := doc.Find("#bookDescriptionBox")
+	if container.Length() == 0 {
+		return nil
+	}
+
+	blockSel := container.Find("p, li")
+	if blockSel.Length() == 0 {
+		if runs := descriptionRuns(container); len(runs) > 0 {
+			return []DescriptionBlock{{Runs: runs}}
+		}
+		return nil
+	}
+
+	var blocks []DescriptionBlock
+	blockSel.Each(func(_ int, block *goquery.Selection) {
+		runs := descriptionRuns(block)
+		if len(runs) == 0 {
+			return
+		}
+		blocks = append(blocks, DescriptionBlock{
+			ListItem: goquery.NodeName(block) == "li",
+			Runs:     runs,
+		})
+	})
+	return blocks
+}
+
+// descriptionRuns walks sel's direct children, turning each into a run:
+// plain text as-is, <b>/<strong> as bold, <i>/<em> as italic, and anything
+// else as plain text (its tag stripped, its text kept).
+func descriptionRuns(sel *goquery.Selection) []DescriptionRun {
+	var runs []DescriptionRun
+	sel.Contents().Each(func(_ int, child *goquery.Selection) {
+		text := child.Text()
+		if strings.TrimSpace(text) == "" {
+			return
+		}
+
+		switch goquery.NodeName(child) {
+		case "script", "style":
+			// Never surface a <script>/<style> tag's contents as text.
+		case "b", "strong":
+			runs = append(runs, DescriptionRun{Text: text, Bold: true})
+		case "i", "em":
+			runs = append(runs, DescriptionRun{Text: text, Italic: true})
+		default:
+			runs = append(runs, DescriptionRun{Text: text})
+		}
+	})
+	return runs
+}
@@ -0,0 +1,80 @@
+package zlibrary
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestParseDescriptionRichParagraphsAndEmphasis(t *testing.T) {
+	html := `<div id="bookDescriptionBox">
+		<p>A <b>desert</b> planet with <i>giant worms</i>.</p>
+		<ul><li>House Atreides</li><li>House Harkonnen</li></ul>
+	</div>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+
+	blocks := parseDescriptionRich(doc)
+	if len(blocks) != 3 {
+		t.Fatalf("got %d blocks, want 3: %+v", len(blocks), blocks)
+	}
+
+	para := blocks[0]
+	if para.ListItem {
+		t.Errorf("first block should not be a list item: %+v", para)
+	}
+	if len(para.Runs) != 5 {
+		t.Fatalf("got %d runs in paragraph, want 5: %+v", len(para.Runs), para.Runs)
+	}
+	if !para.Runs[1].Bold || para.Runs[1].Text != "desert" {
+		t.Errorf("run[1] = %+v, want bold \"desert\"", para.Runs[1])
+	}
+	if !para.Runs[3].Italic || para.Runs[3].Text != "giant worms" {
+		t.Errorf("run[3] = %+v, want italic \"giant worms\"", para.Runs[3])
+	}
+
+	if !blocks[1].ListItem || blocks[1].Runs[0].Text != "House Atreides" {
+		t.Errorf("blocks[1] = %+v, want list item \"House Atreides\"", blocks[1])
+	}
+}
+
+func TestParseDescriptionRichStripsScriptsAndLinks(t *testing.T) {
+	html := `<div id="bookDescriptionBox">
+		<p>See <a href="https://evil.example/track">this review</a> for more.<script>alert(1)</script></p>
+	</div>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+
+	blocks := parseDescriptionRich(doc)
+	if len(blocks) != 1 {
+		t.Fatalf("got %d blocks, want 1: %+v", len(blocks), blocks)
+	}
+
+	var got strings.Builder
+	for _, run := range blocks[0].Runs {
+		got.WriteString(run.Text)
+	}
+	if strings.Contains(got.String(), "alert(1)") {
+		t.Errorf("script contents leaked into runs: %q", got.String())
+	}
+	if !strings.Contains(got.String(), "this review") {
+		t.Errorf("link text was dropped, want it kept as plain text: %q", got.String())
+	}
+}
+
+func TestParseDescriptionRichNoContainer(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html></html>"))
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	if blocks := parseDescriptionRich(doc); blocks != nil {
+		t.Errorf("parseDescriptionRich() = %+v, want nil", blocks)
+	}
+}
@@ -0,0 +1,318 @@
+package zlibrary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/Advik-B/The-Blackbook-Archive/utils"
+)
+
+// ErrBookRemoved indicates a book's details page is gone - either the
+// server returned 404, or it returned a removed-notice page instead of the
+// details markup. Favorites and history entries can check for this with
+// errors.Is to mark a stale link as unavailable rather than showing the raw
+// fetch error.
+var ErrBookRemoved = errors.New("zlibrary: book has been removed")
+
+// GetBookDetails fetches and parses a single book's details page.
+// Concurrent calls for the same detailsURL are coalesced into one request.
+func (c *Client) GetBookDetails(ctx context.Context, detailsURL string) (*BookDetails, error) {
+	return c.detailsCalls.do(detailsURL, func() (*BookDetails, error) {
+		return c.getBookDetailsUncached(ctx, detailsURL)
+	})
+}
+
+func (c *Client) getBookDetailsUncached(ctx context.Context, detailsURL string) (*BookDetails, error) {
+	doc, err := c.fetchDocument(ctx, http.MethodGet, c.resolveURL(detailsURL), nil)
+	if err != nil {
+		return nil, fmt.Errorf("zlibrary: details request: %w", err)
+	}
+	if isRemovedNoticePage(doc) {
+		return nil, ErrBookRemoved
+	}
+
+	authors := c.parseAuthors(doc.Find(".authors").First())
+
+	details := &BookDetails{
+		BookSearchResult: BookSearchResult{
+			Source:     SourceName,
+			DetailsURL: detailsURL,
+			Title:      strings.TrimSpace(doc.Find("h1[itemprop=name]").First().Text()),
+			Author:     joinAuthors(authors),
+			Authors:    authors,
+			CoverURL:   c.resolveURL(attrOr(doc.Find("img.details-book-cover").First(), "data-src", "src")),
+		},
+		Description:     strings.TrimSpace(doc.Find("#bookDescriptionBox").Text()),
+		DescriptionRich: parseDescriptionRich(doc),
+		Publisher:       propertyValue(doc, "publisher"),
+		ISBN10:          propertyValue(doc, "isbn"),
+		ISBN13:          propertyValue(doc, "identifier"),
+		Edition:         propertyValue(doc, "edition"),
+	}
+
+	if pages := firstNumber(propertyValue(doc, "pages")); pages != "" {
+		details.Pages = &pages
+	}
+
+	seriesText, seriesHref := seriesProperty(doc)
+	details.Series, details.SeriesIndex = parseSeriesProperty(seriesText)
+	details.SeriesIndexNumeric = parseSeriesIndexNumeric(details.SeriesIndex)
+	if seriesHref != "" {
+		seriesURL := c.resolveURL(seriesHref)
+		details.SeriesURL = &seriesURL
+	}
+
+	details.Categories = c.parseCategories(doc)
+
+	ipfsLink := doc.Find("a.ipfsDownload, a[data-cid]").First()
+	details.IpfsCID, _ = ipfsLink.Attr("data-cid")
+	details.IpfsCIDBlake2b, _ = ipfsLink.Attr("data-cidb2")
+
+	doc.Find(".book-property__file a").Each(func(_ int, a *goquery.Selection) {
+		href, _ := a.Attr("href")
+
+		sizeText := strings.TrimSpace(a.Parent().Find(".property_size, .size").First().Text())
+		var size *string
+		if sizeText != "" {
+			size = &sizeText
+		}
+
+		details.OtherFormats = append(details.OtherFormats, FormatInfo{
+			Format:   strings.TrimSpace(a.Text()),
+			URL:      href,
+			SizeText: sizeText,
+			Size:     size,
+			// A format listed with no href isn't served directly - it has
+			// to be produced locally by converting a downloadable format.
+			ConversionOnly: href == "",
+			SizeBytes:      parseSizeText(sizeText),
+		})
+	})
+
+	if dl, ok := doc.Find("a.addDownloadedBook").Attr("href"); ok && dl != "" {
+		details.DownloadURL = c.resolveURL(dl)
+	} else if decoded := decodeObfuscatedDownloadLink(doc); decoded != "" {
+		details.DownloadURL = c.resolveURL(decoded)
+	}
+
+	c.dedupeOtherFormats(details)
+
+	details.Availability, details.AvailabilityNotice = detectAvailability(doc, details)
+
+	applyJSONLDFallback(details, doc)
+
+	details.ISBN10, details.ISBN13 = utils.NormalizeISBNPair(details.ISBN10, details.ISBN13)
+
+	return details, nil
+}
+
+// detectAvailability classifies why a book's details page does or doesn't
+// offer a download, beyond the bare presence of DownloadURL - a premium-only
+// or region-blocked notice gets its own Availability value and the page's
+// own wording, rather than the page just ending up with a nil DownloadURL
+// and no explanation.
+func detectAvailability(doc *goquery.Document, d *BookDetails) (Availability, string) {
+	if notice := noticeText(doc, ".premium-only, #premiumOnlyNotice"); notice != "" {
+		return AvailabilityPremiumOnly, notice
+	}
+	if notice := noticeText(doc, ".region-blocked, #regionBlockedNotice"); notice != "" {
+		return AvailabilityRegionBlocked, notice
+	}
+	if d.DownloadURL != "" || len(d.OtherFormats) > 0 {
+		return AvailabilityAvailable, ""
+	}
+	return AvailabilityUnknown, ""
+}
+
+// noticeText returns the trimmed text of the first element matching
+// selector, or "" if there isn't one.
+func noticeText(doc *goquery.Document, selector string) string {
+	sel := doc.Find(selector).First()
+	if sel.Length() == 0 {
+		return ""
+	}
+	return strings.TrimSpace(sel.Text())
+}
+
+// GetBookDetailsBatch fetches details for every URL concurrently, preserving
+// input order in the returned slice. A per-URL failure is returned alongside
+// the other successful results rather than aborting the whole batch.
+// Launches are paced with a small jittered delay (see SetBatchDelay) so a
+// large batch doesn't fire every request in the same instant; a launch that
+// observes ctx cancellation during that pause, and everything after it,
+// fails with ctx.Err() instead of being started at all.
+func (c *Client) GetBookDetailsBatch(ctx context.Context, detailsURLs []string) ([]*BookDetails, []error) {
+	results := make([]*BookDetails, len(detailsURLs))
+	errs := make([]error, len(detailsURLs))
+
+	var wg sync.WaitGroup
+	for i, u := range detailsURLs {
+		if i > 0 {
+			if err := c.sleepBatchDelay(ctx); err != nil {
+				for j := i; j < len(detailsURLs); j++ {
+					errs[j] = err
+				}
+				break
+			}
+		}
+
+		wg.Add(1)
+		go func(i int, u string) {
+			defer wg.Done()
+			results[i], errs[i] = c.GetBookDetails(ctx, u)
+		}(i, u)
+	}
+	wg.Wait()
+
+	return results, errs
+}
+
+// resolveURL turns maybeRelative into an absolute URL against the client's
+// base URL, correctly handling root-relative ("/path"), protocol-relative
+// ("//covers.example.com/x.jpg"), and already-absolute URLs pointing at a
+// different host (e.g. a CDN) - the last of which a plain string-prefix
+// join would mangle.
+func (c *Client) resolveURL(maybeRelative string) string {
+	if maybeRelative == "" {
+		return ""
+	}
+
+	base, err := url.Parse(c.BaseURL())
+	if err != nil {
+		return maybeRelative
+	}
+	ref, err := url.Parse(maybeRelative)
+	if err != nil {
+		return maybeRelative
+	}
+
+	resolved := base.ResolveReference(ref).String()
+
+	c.mu.Lock()
+	safeMode := c.safeMode
+	c.mu.Unlock()
+	if safeMode {
+		resolved = stripTrackingParams(resolved)
+	}
+
+	return resolved
+}
+
+// propertyValue looks a book property up by its CSS class (e.g.
+// "publisher", "isbn"), not by its visible label text. Z-Library keeps
+// these class names stable across interface languages - a page rendered
+// in Russian still uses ".book-property__publisher", just with "Издатель"
+// as the label a human reads - so changing Client.SetLanguage never
+// requires a label-to-key translation table here.
+func propertyValue(doc *goquery.Document, property string) string {
+	return strings.TrimSpace(doc.Find(fmt.Sprintf(".book-property__%s .property_value", property)).First().Text())
+}
+
+// seriesProperty returns the series property's display text and, if the
+// name is a link to the series' own listing page, that link's href.
+func seriesProperty(doc *goquery.Document) (text string, href string) {
+	value := doc.Find(".book-property__series .property_value").First()
+
+	if link := value.Find("a").First(); link.Length() > 0 {
+		href, _ = link.Attr("href")
+		return strings.TrimSpace(link.Text()), href
+	}
+
+	return strings.TrimSpace(value.Text()), ""
+}
+
+var firstNumberRe = regexp.MustCompile(`\d+`)
+
+// firstNumber extracts the first run of digits in s, for properties like
+// "Pages: 412 / 430" where only the first number (total pages, not the
+// index some mirrors append) is meaningful.
+func firstNumber(s string) string {
+	return firstNumberRe.FindString(s)
+}
+
+// seriesIndexRe matches a trailing "(Book 3)", "#3", or "Vol. 2" style
+// position marker on a series property's text, capturing just the number.
+var seriesIndexRe = regexp.MustCompile(`(?i)\(?(?:book|vol\.?|volume|#)\s*(\d+(?:\.\d+)?)\)?\s*$`)
+
+// parseSeriesProperty splits a series property's raw text, such as
+// "Mistborn (Book 3)", into the series name and its index within the
+// series. A property with no recognizable index returns a nil index.
+func parseSeriesProperty(raw string) (name string, index *string) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", nil
+	}
+
+	match := seriesIndexRe.FindStringSubmatchIndex(raw)
+	if match == nil {
+		return raw, nil
+	}
+
+	name = strings.TrimSpace(raw[:match[0]])
+	idx := raw[match[2]:match[3]]
+	return name, &idx
+}
+
+// parseSeriesIndexNumeric converts a SeriesIndex string like "3" or "2.5"
+// into a float64, for sorting and metadata export. nil in, or a value that
+// isn't purely numeric, both yield nil out.
+func parseSeriesIndexNumeric(index *string) *float64 {
+	if index == nil {
+		return nil
+	}
+	n, err := strconv.ParseFloat(strings.TrimSpace(*index), 64)
+	if err != nil {
+		return nil
+	}
+	return &n
+}
+
+// parseCategories reads the category breadcrumb(s) from a book's details
+// page. Most mirrors render one trail per book as a chain of anchors under
+// a single container, from the top-level category down to the most
+// specific one; each anchor after the first has its preceding sibling's
+// name recorded as its Parent. Mirrors that only list flat categories (no
+// breadcrumb chain) still work - every category just comes back top-level.
+func (c *Client) parseCategories(doc *goquery.Document) []Category {
+	var categories []Category
+
+	doc.Find(".book-property__categories, .categories").Each(func(_ int, trail *goquery.Selection) {
+		var parent *string
+		trail.Find("a").Each(func(_ int, a *goquery.Selection) {
+			name := strings.TrimSpace(a.Text())
+			if name == "" {
+				return
+			}
+			href, _ := a.Attr("href")
+
+			categories = append(categories, Category{
+				Name:   name,
+				URL:    c.resolveURL(href),
+				Parent: parent,
+			})
+
+			parentName := name
+			parent = &parentName
+		})
+	})
+
+	return categories
+}
+
+func attrOr(sel *goquery.Selection, attrs ...string) string {
+	for _, a := range attrs {
+		if v, ok := sel.Attr(a); ok && v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,27 @@
+package zlibrary
+
+import "testing"
+
+func TestResolveURL(t *testing.T) {
+	c := NewClient()
+	c.SetBaseURL("https://z-lib.io")
+
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"root-relative", "/covers/x.jpg", "https://z-lib.io/covers/x.jpg"},
+		{"protocol-relative", "//covers.cdn.example.com/x.jpg", "https://covers.cdn.example.com/x.jpg"},
+		{"absolute-other-host", "https://static.otherhost.com/covers/x.jpg", "https://static.otherhost.com/covers/x.jpg"},
+		{"empty", "", ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := c.resolveURL(tc.in); got != tc.want {
+				t.Errorf("resolveURL(%q) = %q, want %q", tc.in, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package zlibrary
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// mirrorDiscoveryCandidates lists well-known Z-Library landing domains
+// DiscoverMirrors probes for one that's currently live. This list rots the
+// same way any hard-coded mirror does - it's a starting point for an
+// automatic search, not a guarantee, and a user can always type a mirror
+// in directly in Settings instead.
+var mirrorDiscoveryCandidates = []string{
+	"https://z-lib.io",
+	"https://z-lib.gs",
+	"https://1lib.sk",
+}
+
+// mirrorDiscoveryTimeout bounds how long probing a single candidate may
+// take, independent of how many candidates there are to get through.
+const mirrorDiscoveryTimeout = 10 * time.Second
+
+// DiscoveredMirror is one candidate DiscoverMirrors found to be live,
+// ranked by how quickly it responded.
+type DiscoveredMirror struct {
+	BaseURL string
+	Latency time.Duration
+}
+
+// DiscoverMirrors probes mirrorDiscoveryCandidates concurrently, following
+// redirects to find each one's current landing domain, and keeps only the
+// candidates that both responded and didn't come back as a block/challenge
+// page (see isBlockedResponse). The result is sorted fastest-first, so
+// callers can just take the first entry as "the" discovered mirror.
+func (c *Client) DiscoverMirrors(ctx context.Context) ([]DiscoveredMirror, error) {
+	var (
+		mu      sync.Mutex
+		results []DiscoveredMirror
+	)
+
+	var wg sync.WaitGroup
+	for _, candidate := range mirrorDiscoveryCandidates {
+		candidate := candidate
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			probeCtx, cancel := context.WithTimeout(ctx, mirrorDiscoveryTimeout)
+			defer cancel()
+
+			start := time.Now()
+			probe := c.cloneForMirror(candidate)
+			ok, finalURL, err := probe.CheckConnectivity(probeCtx)
+			if err != nil || !ok {
+				return
+			}
+
+			mu.Lock()
+			results = append(results, DiscoveredMirror{BaseURL: finalURL, Latency: time.Since(start)})
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if len(results) == 0 {
+		return nil, fmt.Errorf("zlibrary: no working mirror found among %d candidates", len(mirrorDiscoveryCandidates))
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Latency < results[j].Latency })
+	return results, nil
+}
@@ -0,0 +1,59 @@
+package zlibrary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDiscoverMirrorsRanksFastestFirstAndSkipsBlocked(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		w.Write([]byte(`<html><body>ok</body></html>`))
+	}))
+	defer slow.Close()
+
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><body>ok</body></html>`))
+	}))
+	defer fast.Close()
+
+	blocked := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Server", "cloudflare")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte("checking your browser before accessing"))
+	}))
+	defer blocked.Close()
+
+	orig := mirrorDiscoveryCandidates
+	mirrorDiscoveryCandidates = []string{slow.URL, fast.URL, blocked.URL}
+	defer func() { mirrorDiscoveryCandidates = orig }()
+
+	c := NewClient()
+	results, err := c.DiscoverMirrors(context.Background())
+	if err != nil {
+		t.Fatalf("DiscoverMirrors: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("DiscoverMirrors() returned %d results, want 2 (blocked candidate should be excluded)", len(results))
+	}
+	if results[0].BaseURL != fast.URL {
+		t.Errorf("results[0].BaseURL = %q, want fastest mirror %q", results[0].BaseURL, fast.URL)
+	}
+	if results[0].Latency > results[1].Latency {
+		t.Errorf("results not sorted fastest-first: %v", results)
+	}
+}
+
+func TestDiscoverMirrorsErrorsWhenNoneReachable(t *testing.T) {
+	orig := mirrorDiscoveryCandidates
+	mirrorDiscoveryCandidates = []string{"http://127.0.0.1:0"}
+	defer func() { mirrorDiscoveryCandidates = orig }()
+
+	c := NewClient()
+	if _, err := c.DiscoverMirrors(context.Background()); err == nil {
+		t.Fatal("DiscoverMirrors() err = nil, want error when no candidate is reachable")
+	}
+}
@@ -0,0 +1,38 @@
+package zlibrary
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// DownloadRequest builds the HTTP request that fetches the given format of
+// a book. format is matched against details.OtherFormats first, falling
+// back to details.DownloadURL whenever no OtherFormats entry matches (an
+// empty format, the primary format, or any other unlisted name).
+func (c *Client) DownloadRequest(ctx context.Context, details *BookDetails, format string) (*http.Request, error) {
+	url := details.DownloadURL
+	for _, f := range details.OtherFormats {
+		if f.Format == format {
+			url = c.resolveURL(f.URL)
+			break
+		}
+	}
+	if url == "" {
+		return nil, fmt.Errorf("zlibrary: no download URL available for format %q", format)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Some /dl/ endpoints check that the Referer is the book's own page
+	// and serve a limit page to anything else, so send that explicitly
+	// rather than relying on whatever the client last happened to fetch.
+	if bookPage := c.resolveURL(details.DetailsURL); bookPage != "" {
+		req.Header.Set("Referer", bookPage)
+	}
+
+	return req, nil
+}
@@ -0,0 +1,44 @@
+package zlibrary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDownloadRequestSendsBookPageReferer(t *testing.T) {
+	const bookPage = "/book/12345"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Referer") == "" || r.Header.Get("Referer")[len(r.Header.Get("Referer"))-len(bookPage):] != bookPage {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c := NewClient()
+	c.SetBaseURL(srv.URL)
+
+	details := &BookDetails{
+		BookSearchResult: BookSearchResult{DetailsURL: bookPage},
+		DownloadURL:      srv.URL + "/dl/12345",
+	}
+
+	req, err := c.DownloadRequest(context.Background(), details, "")
+	if err != nil {
+		t.Fatalf("DownloadRequest: %v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status %d, want %d (server requires the book page as Referer)", resp.StatusCode, http.StatusOK)
+	}
+}
@@ -0,0 +1,43 @@
+package zlibrary
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/andybalholm/brotli"
+)
+
+// decodeBody wraps resp.Body with the appropriate decompressor based on its
+// Content-Encoding header. MakeRequest sets Accept-Encoding itself (to ask
+// for brotli, which Go's transport won't negotiate on its own), and doing so
+// disables the transport's usual transparent gzip handling - so from here on
+// we have to decode both encodings ourselves.
+func decodeBody(resp *http.Response) error {
+	switch resp.Header.Get("Content-Encoding") {
+	case "gzip":
+		reader, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return fmt.Errorf("zlibrary: gzip decode: %w", err)
+		}
+		resp.Body = decodedBody{reader, resp.Body}
+	case "br":
+		resp.Body = decodedBody{brotli.NewReader(resp.Body), resp.Body}
+	case "", "identity":
+		return nil
+	default:
+		return fmt.Errorf("zlibrary: unsupported Content-Encoding %q", resp.Header.Get("Content-Encoding"))
+	}
+
+	resp.Header.Del("Content-Encoding")
+	resp.ContentLength = -1
+	return nil
+}
+
+// decodedBody pairs a decompressing Reader with the original response
+// body's Closer, so callers can keep calling resp.Body.Close() as usual.
+type decodedBody struct {
+	io.Reader
+	io.Closer
+}
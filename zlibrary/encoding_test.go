@@ -0,0 +1,70 @@
+package zlibrary
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+)
+
+func TestMakeRequestDecodesGzip(t *testing.T) {
+	const want = "<html><body>gzip fixture</body></html>"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		gz.Write([]byte(want))
+		gz.Close()
+	}))
+	defer srv.Close()
+
+	c := NewClient()
+	resp, err := c.MakeRequest(context.Background(), http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("MakeRequest: %v", err)
+	}
+	defer resp.Body.Close()
+
+	got, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	if string(got) != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestMakeRequestDecodesBrotli(t *testing.T) {
+	const want = "<html><body>brotli fixture</body></html>"
+
+	var buf bytes.Buffer
+	bw := brotli.NewWriter(&buf)
+	bw.Write([]byte(want))
+	bw.Close()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "br")
+		w.Write(buf.Bytes())
+	}))
+	defer srv.Close()
+
+	c := NewClient()
+	resp, err := c.MakeRequest(context.Background(), http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("MakeRequest: %v", err)
+	}
+	defer resp.Body.Close()
+
+	got, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	if string(got) != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,35 @@
+package zlibrary
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var sizeTextRe = regexp.MustCompile(`(?i)([\d.]+)\s*(B|KB|MB|GB)\b`)
+
+// parseSizeText converts a human-readable size like "4.2 MB" into bytes.
+// It returns 0 if s doesn't contain a recognizable size.
+func parseSizeText(s string) int64 {
+	match := sizeTextRe.FindStringSubmatch(s)
+	if match == nil {
+		return 0
+	}
+
+	value, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0
+	}
+
+	var unit float64 = 1
+	switch strings.ToUpper(match[2]) {
+	case "KB":
+		unit = 1 << 10
+	case "MB":
+		unit = 1 << 20
+	case "GB":
+		unit = 1 << 30
+	}
+
+	return int64(value * unit)
+}
@@ -0,0 +1,18 @@
+package zlibrary
+
+import "testing"
+
+func TestParseSizeText(t *testing.T) {
+	cases := map[string]int64{
+		"4.2 MB":     4404019, // 4.2 * 2^20 = 4404019.2, truncated
+		"1 GB":       1 << 30,
+		"500 KB":     500 << 10,
+		"no size":    0,
+		"":           0,
+	}
+	for in, want := range cases {
+		if got := parseSizeText(in); got != want {
+			t.Errorf("parseSizeText(%q) = %d, want %d", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package zlibrary
+
+import "strings"
+
+// dedupeOtherFormats removes redundant entries from details.OtherFormats:
+// anything that resolves to the same URL as DownloadURL (so the primary
+// format doesn't also show up as an "other" one), and repeats of the same
+// format name, keeping the first occurrence of each. The scraped markup
+// occasionally lists the same format twice, or lists the primary download
+// format again under "other formats" - OtherFormats URLs are stored
+// unresolved, same as everywhere else they're used, so resolveURL is only
+// used here for the comparison itself.
+func (c *Client) dedupeOtherFormats(details *BookDetails) {
+	if len(details.OtherFormats) == 0 {
+		return
+	}
+
+	seen := make(map[string]bool, len(details.OtherFormats))
+	deduped := make([]FormatInfo, 0, len(details.OtherFormats))
+	for _, f := range details.OtherFormats {
+		if details.DownloadURL != "" && f.URL != "" && c.resolveURL(f.URL) == details.DownloadURL {
+			continue
+		}
+
+		key := strings.ToUpper(strings.TrimSpace(f.Format))
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		deduped = append(deduped, f)
+	}
+	details.OtherFormats = deduped
+}
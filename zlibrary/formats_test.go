@@ -0,0 +1,48 @@
+package zlibrary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetBookDetailsDedupesOverlappingOtherFormats(t *testing.T) {
+	page := `<html><body>
+		<h1 itemprop="name">Dune</h1>
+		<div class="authors"><a>Frank Herbert</a></div>
+		<a class="addDownloadedBook" href="/download/123.epub"></a>
+		<div class="book-property__file">
+			<a href="/download/123.epub">EPUB</a>
+			<div class="property_size">2 MB</div>
+		</div>
+		<div class="book-property__file">
+			<a href="/download/123.epub">epub</a>
+			<div class="property_size">2 MB</div>
+		</div>
+		<div class="book-property__file">
+			<a href="/download/123.mobi">MOBI</a>
+			<div class="property_size">3 MB</div>
+		</div>
+	</body></html>`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(page))
+	}))
+	defer server.Close()
+
+	c := NewClient()
+	c.SetBaseURL(server.URL)
+
+	d, err := c.GetBookDetails(context.Background(), "/book/123")
+	if err != nil {
+		t.Fatalf("GetBookDetails: %v", err)
+	}
+
+	if len(d.OtherFormats) != 1 {
+		t.Fatalf("OtherFormats = %v, want exactly the MOBI entry", d.OtherFormats)
+	}
+	if d.OtherFormats[0].Format != "MOBI" {
+		t.Errorf("OtherFormats[0].Format = %q, want MOBI", d.OtherFormats[0].Format)
+	}
+}
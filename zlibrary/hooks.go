@@ -0,0 +1,82 @@
+package zlibrary
+
+import (
+	"log"
+	"net/http"
+	"time"
+)
+
+// RequestHook is called immediately before a request is sent.
+type RequestHook func(req *http.Request)
+
+// ResponseHook is called once a request has completed, whether it
+// succeeded or failed at the transport level. req is the same request
+// RequestHook saw for this exchange, so a caller can report on it even
+// when resp is nil. err is non-nil and resp is nil on a transport failure
+// (DNS, timeout, connection refused, etc) - the hook is still called so a
+// caller tracking in-flight counts or error rates doesn't have to
+// special-case that path.
+type ResponseHook func(req *http.Request, resp *http.Response, d time.Duration, err error)
+
+// SetRequestHook installs fn to be called before every request this client
+// sends. Replaces any previously set hook; nil disables it. Useful for
+// debugging, metrics, or a UI "requests in flight" indicator without
+// sprinkling logging through the scraper itself - see NewDebugLogHooks and
+// StatsCollector for ready-made hooks.
+func (c *Client) SetRequestHook(fn RequestHook) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.requestHook = fn
+}
+
+// SetResponseHook installs fn to be called after every request this client
+// sends, including on a transport error. Replaces any previously set hook;
+// nil disables it.
+func (c *Client) SetResponseHook(fn ResponseHook) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responseHook = fn
+}
+
+// doHTTP sends req through httpClient, invoking the request/response hooks
+// around the call. It's the only place httpClient.Do is called, so every
+// code path - including the 429 retry in retryAfterRateLimit - is observed
+// the same way.
+func (c *Client) doHTTP(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	reqHook, respHook := c.requestHook, c.responseHook
+	c.mu.Unlock()
+
+	if reqHook != nil {
+		reqHook(req)
+	}
+
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
+	if respHook != nil {
+		respHook(req, resp, time.Since(start), err)
+	}
+	return resp, err
+}
+
+// NewDebugLogHooks returns a request/response hook pair that logs every
+// exchange to logger (log.Default() if nil), for wiring in with
+// SetRequestHook/SetResponseHook when chasing down a scraping issue
+// interactively.
+func NewDebugLogHooks(logger *log.Logger) (RequestHook, ResponseHook) {
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	reqHook := func(req *http.Request) {
+		logger.Printf("zlibrary: -> %s %s", req.Method, req.URL)
+	}
+	respHook := func(req *http.Request, resp *http.Response, d time.Duration, err error) {
+		if err != nil {
+			logger.Printf("zlibrary: <- error after %s: %v %s", d, err, req.URL)
+			return
+		}
+		logger.Printf("zlibrary: <- %d %s (%s)", resp.StatusCode, resp.Request.URL, d)
+	}
+	return reqHook, respHook
+}
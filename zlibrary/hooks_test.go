@@ -0,0 +1,68 @@
+package zlibrary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestAndResponseHooksFireOnSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	c := NewClient()
+
+	var reqSeen, respSeen int32
+	c.SetRequestHook(func(req *http.Request) { atomic.AddInt32(&reqSeen, 1) })
+	c.SetResponseHook(func(req *http.Request, resp *http.Response, d time.Duration, err error) {
+		atomic.AddInt32(&respSeen, 1)
+		if err != nil {
+			t.Errorf("response hook got err = %v, want nil", err)
+		}
+	})
+
+	resp, err := c.MakeRequest(context.Background(), http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("MakeRequest: %v", err)
+	}
+	resp.Body.Close()
+
+	if atomic.LoadInt32(&reqSeen) != 1 {
+		t.Errorf("request hook called %d times, want 1", reqSeen)
+	}
+	if atomic.LoadInt32(&respSeen) != 1 {
+		t.Errorf("response hook called %d times, want 1", respSeen)
+	}
+}
+
+func TestResponseHookFiresOnTransportError(t *testing.T) {
+	c := NewClient()
+
+	var respSeen int32
+	var gotErr error
+	var gotReq *http.Request
+	c.SetResponseHook(func(req *http.Request, resp *http.Response, d time.Duration, err error) {
+		atomic.AddInt32(&respSeen, 1)
+		gotErr = err
+		gotReq = req
+	})
+
+	_, err := c.MakeRequest(context.Background(), http.MethodGet, "http://127.0.0.1:0", nil)
+	if err == nil {
+		t.Fatal("MakeRequest err = nil, want a transport error")
+	}
+	if atomic.LoadInt32(&respSeen) != 1 {
+		t.Fatalf("response hook called %d times, want 1", respSeen)
+	}
+	if gotErr == nil {
+		t.Error("response hook saw err = nil, want the transport error")
+	}
+	if gotReq == nil {
+		t.Error("response hook saw req = nil, want the original request")
+	}
+}
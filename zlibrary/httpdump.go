@@ -0,0 +1,77 @@
+package zlibrary
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// SetHTTPDump turns on full request/response logging to dir: every
+// request's method, URL, and headers (with any Cookie header redacted)
+// alongside the raw response body, each written to its own timestamped
+// file. Off by default - this captures far more than SetDebugSnapshotDir's
+// failure-only snapshots, so it's meant for attaching to a bug report
+// while actively diagnosing scraper breakage, not routine use. An empty
+// dir disables it.
+func (c *Client) SetHTTPDump(dir string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.httpDumpDir = dir
+}
+
+func (c *Client) httpDumpDirLocked() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.httpDumpDir
+}
+
+// dumpHTTPExchange reads resp's full body to write the dump file, then
+// replaces resp.Body with a fresh reader over those same bytes so the
+// caller can still consume it normally.
+func dumpHTTPExchange(dir string, req *http.Request, resp *http.Response) (*http.Response, error) {
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	writeHTTPDump(dir, req, resp, data)
+
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	return resp, nil
+}
+
+// writeHTTPDump best-effort writes one request/response exchange to dir.
+// Failures are silently ignored - this is a debugging aid, not something
+// that should itself become a new error path.
+func writeHTTPDump(dir string, req *http.Request, resp *http.Response, body []byte) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return
+	}
+
+	sum := sha1.Sum([]byte(req.URL.String()))
+	name := fmt.Sprintf("%s-%s.log", time.Now().Format("20060102T150405.000"), hex.EncodeToString(sum[:8]))
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "%s %s\n", req.Method, req.URL.String())
+	for key, values := range req.Header {
+		if strings.EqualFold(key, "Cookie") {
+			out.WriteString("Cookie: [redacted]\n")
+			continue
+		}
+		for _, v := range values {
+			fmt.Fprintf(&out, "%s: %s\n", key, v)
+		}
+	}
+	fmt.Fprintf(&out, "\n-- response: %s --\n\n", resp.Status)
+	out.Write(body)
+
+	_ = os.WriteFile(filepath.Join(dir, name), []byte(out.String()), 0o644)
+}
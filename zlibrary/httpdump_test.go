@@ -0,0 +1,56 @@
+package zlibrary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestHTTPDumpWritesRequestAndResponseRedactingCookies(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<html>hello</html>"))
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	c := NewClient()
+	c.SetBaseURL(srv.URL)
+	c.SetHTTPDump(dir)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	req.Header.Set("Cookie", "session=supersecret")
+
+	resp, err := c.do(req)
+	if err != nil {
+		t.Fatalf("do: %v", err)
+	}
+	resp.Body.Close()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("got %d dump files, want 1", len(entries))
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+
+	dump := string(data)
+	if strings.Contains(dump, "supersecret") {
+		t.Errorf("dump contains unredacted cookie: %s", dump)
+	}
+	if !strings.Contains(dump, "hello") {
+		t.Errorf("dump missing response body: %s", dump)
+	}
+}
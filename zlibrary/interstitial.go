@@ -0,0 +1,130 @@
+package zlibrary
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/Advik-B/The-Blackbook-Archive/utils"
+)
+
+// isInterstitialPage reports whether doc is the site's age-gate / consent
+// interstitial rather than the page we actually requested.
+func isInterstitialPage(doc *goquery.Document) bool {
+	return doc.Find("form.age-gate-form, #consent-form").Length() > 0
+}
+
+// isRemovedNoticePage reports whether doc is a "this book has been removed"
+// notice rather than an actual details page - some mirrors serve this with
+// a 200 status instead of a 404.
+func isRemovedNoticePage(doc *goquery.Document) bool {
+	return doc.Find(".book-removed-notice, #bookRemovedNotice").Length() > 0
+}
+
+// acceptInterstitial submits the interstitial's own consent form (carrying
+// whatever hidden fields it came with) so the site sets whatever cookie it
+// needs, then returns. The caller is expected to retry its original
+// request afterward.
+func (c *Client) acceptInterstitial(ctx context.Context, doc *goquery.Document) error {
+	form := doc.Find("form.age-gate-form, #consent-form").First()
+	action, _ := form.Attr("action")
+	if action == "" {
+		return fmt.Errorf("zlibrary: interstitial page had no consent form action")
+	}
+
+	data := url.Values{}
+	form.Find("input").Each(func(_ int, input *goquery.Selection) {
+		if name, ok := input.Attr("name"); ok && name != "" {
+			value, _ := input.Attr("value")
+			data.Set(name, value)
+		}
+	})
+
+	resp, err := c.MakeRequest(ctx, http.MethodPost, c.resolveURL(action), strings.NewReader(data.Encode()))
+	if err != nil {
+		return fmt.Errorf("zlibrary: accept interstitial: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// fetchDocument issues the request and parses the response as HTML,
+// transparently accepting and retrying once past the site's consent
+// interstitial if one is encountered.
+func (c *Client) fetchDocument(ctx context.Context, method, requestURL string, body io.Reader) (*goquery.Document, error) {
+	doc, _, err := c.fetchDocumentWithURL(ctx, method, requestURL, body)
+	return doc, err
+}
+
+// fetchDocumentWithURL works like fetchDocument, but additionally returns
+// the URL the response actually came from, which differs from requestURL
+// when the server redirected - e.g. a search query that resolves straight
+// to a single book's details page. See SearchRedirect.
+func (c *Client) fetchDocumentWithURL(ctx context.Context, method, requestURL string, body io.Reader) (*goquery.Document, string, error) {
+	resp, err := c.MakeRequest(ctx, method, requestURL, body)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, "", c.errorForFailedFetch(requestURL, resp)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !isInterstitialPage(doc) {
+		return doc, resp.Request.URL.String(), nil
+	}
+
+	if err := c.acceptInterstitial(ctx, doc); err != nil {
+		return nil, "", err
+	}
+
+	resp, err = c.MakeRequest(ctx, method, requestURL, body)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, "", c.errorForFailedFetch(requestURL, resp)
+	}
+
+	doc, err = goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	return doc, resp.Request.URL.String(), nil
+}
+
+// errorForFailedFetch builds the error returned for a 4xx/5xx response. A
+// 404 is reported as ErrBookRemoved without reading the body. Any other
+// status yields an error that only ever carries a bounded snippet of the
+// body - never the whole thing, which for an HTML error page can run to
+// megabytes - with the full body going to the debug snapshot directory when
+// one is configured.
+func (c *Client) errorForFailedFetch(requestURL string, resp *http.Response) error {
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("zlibrary: %s returned %s: %w", requestURL, resp.Status, ErrBookRemoved)
+	}
+
+	dir := c.snapshotDir()
+	if dir == "" {
+		return fmt.Errorf("zlibrary: %s returned %s: %s", requestURL, resp.Status, utils.ReadSnippet(resp.Body, utils.DefaultSnippetBytes))
+	}
+
+	data, _ := io.ReadAll(resp.Body)
+	writeDebugSnapshot(dir, requestURL, data)
+	return fmt.Errorf("zlibrary: %s returned %s: %s", requestURL, resp.Status, utils.ReadSnippet(bytes.NewReader(data), utils.DefaultSnippetBytes))
+}
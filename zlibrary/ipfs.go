@@ -0,0 +1,39 @@
+package zlibrary
+
+import "strings"
+
+// defaultIPFSGateway is used whenever no gateways have been configured via
+// SetIPFSGateways.
+const defaultIPFSGateway = "https://ipfs.io/ipfs/"
+
+// SetIPFSGateways overrides the gateways IPFSGatewayURLs builds URLs
+// against, in preference order. An empty slice resets to the built-in
+// default gateway.
+func (c *Client) SetIPFSGateways(gateways []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ipfsGateways = gateways
+}
+
+// IPFSGatewayURLs resolves cid against every configured gateway, in the
+// same order they were set, so a caller can offer a choice (or fall
+// through them) when one gateway is slow or unreachable. Returns nil for
+// an empty cid.
+func (c *Client) IPFSGatewayURLs(cid string) []string {
+	if cid == "" {
+		return nil
+	}
+
+	c.mu.Lock()
+	gateways := c.ipfsGateways
+	c.mu.Unlock()
+	if len(gateways) == 0 {
+		gateways = []string{defaultIPFSGateway}
+	}
+
+	urls := make([]string, len(gateways))
+	for i, gateway := range gateways {
+		urls[i] = strings.TrimRight(gateway, "/") + "/" + cid
+	}
+	return urls
+}
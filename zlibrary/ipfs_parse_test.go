@@ -0,0 +1,27 @@
+package zlibrary
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestDetailsParsesIPFSCIDs(t *testing.T) {
+	html := `<a class="ipfsDownload" data-cid="bafy111" data-cidb2="bafyb2v222">IPFS</a>`
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+
+	ipfsLink := doc.Find("a.ipfsDownload, a[data-cid]").First()
+	cid, _ := ipfsLink.Attr("data-cid")
+	cidB2, _ := ipfsLink.Attr("data-cidb2")
+
+	if cid != "bafy111" {
+		t.Errorf("cid = %q, want bafy111", cid)
+	}
+	if cidB2 != "bafyb2v222" {
+		t.Errorf("cidB2 = %q, want bafyb2v222", cidB2)
+	}
+}
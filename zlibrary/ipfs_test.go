@@ -0,0 +1,33 @@
+package zlibrary
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIPFSGatewayURLsDefaultGateway(t *testing.T) {
+	c := NewClient()
+	got := c.IPFSGatewayURLs("bafy123")
+	want := []string{"https://ipfs.io/ipfs/bafy123"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("IPFSGatewayURLs() = %v, want %v", got, want)
+	}
+}
+
+func TestIPFSGatewayURLsCustomGateways(t *testing.T) {
+	c := NewClient()
+	c.SetIPFSGateways([]string{"https://gateway.one/ipfs/", "https://gateway.two/ipfs"})
+
+	got := c.IPFSGatewayURLs("bafy123")
+	want := []string{"https://gateway.one/ipfs/bafy123", "https://gateway.two/ipfs/bafy123"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("IPFSGatewayURLs() = %v, want %v", got, want)
+	}
+}
+
+func TestIPFSGatewayURLsEmptyCID(t *testing.T) {
+	c := NewClient()
+	if got := c.IPFSGatewayURLs(""); got != nil {
+		t.Errorf("IPFSGatewayURLs(\"\") = %v, want nil", got)
+	}
+}
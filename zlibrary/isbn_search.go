@@ -0,0 +1,13 @@
+package zlibrary
+
+import "context"
+
+// SearchByISBN looks up a book by ISBN-10 or ISBN-13, treating the ISBN as
+// a search query the same way a user typing it into the search bar would -
+// Z-Library's own search already matches against ISBN metadata, so there's
+// no separate lookup endpoint to call. Callers that need to tell "no
+// match" apart from "more than one candidate" should inspect the length of
+// the returned slice.
+func (c *Client) SearchByISBN(ctx context.Context, isbn string) ([]BookSearchResult, error) {
+	return c.SearchZLibrary(ctx, isbn, 1)
+}
@@ -0,0 +1,65 @@
+package zlibrary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetBookDetailsCorrectsSwappedISBNSlots(t *testing.T) {
+	page := `<html><body>
+		<h1 itemprop="name">Structure and Interpretation of Computer Programs</h1>
+		<div class="authors"><a>Harold Abelson</a></div>
+		<div class="book-property__isbn"><div class="property_value">9780306406157</div></div>
+		<div class="book-property__identifier"><div class="property_value">0-306-40615-2</div></div>
+	</body></html>`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(page))
+	}))
+	defer server.Close()
+
+	c := NewClient()
+	c.SetBaseURL(server.URL)
+
+	d, err := c.GetBookDetails(context.Background(), "/book/123")
+	if err != nil {
+		t.Fatalf("GetBookDetails: %v", err)
+	}
+
+	if d.ISBN10 != "0306406152" {
+		t.Errorf("ISBN10 = %q, want 0306406152", d.ISBN10)
+	}
+	if d.ISBN13 != "9780306406157" {
+		t.Errorf("ISBN13 = %q, want 9780306406157", d.ISBN13)
+	}
+}
+
+func TestGetBookDetailsClearsCorruptISBN(t *testing.T) {
+	page := `<html><body>
+		<h1 itemprop="name">Some Book</h1>
+		<div class="authors"><a>Some Author</a></div>
+		<div class="book-property__isbn"><div class="property_value">not-an-isbn</div></div>
+	</body></html>`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(page))
+	}))
+	defer server.Close()
+
+	c := NewClient()
+	c.SetBaseURL(server.URL)
+
+	d, err := c.GetBookDetails(context.Background(), "/book/456")
+	if err != nil {
+		t.Fatalf("GetBookDetails: %v", err)
+	}
+
+	if d.ISBN10 != "" {
+		t.Errorf("ISBN10 = %q, want cleared to empty", d.ISBN10)
+	}
+	if d.ISBN13 != "" {
+		t.Errorf("ISBN13 = %q, want empty", d.ISBN13)
+	}
+}
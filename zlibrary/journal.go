@@ -0,0 +1,85 @@
+package zlibrary
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// journalCapacity caps how many recent exchanges a RequestJournal retains,
+// so a long-running session's journal doesn't grow unboundedly - the same
+// bounded-window approach statsLatencyWindow takes for latencies.
+const journalCapacity = 200
+
+// JournalEntry is one request/response exchange recorded by a
+// RequestJournal.
+type JournalEntry struct {
+	Method   string
+	URL      string
+	Status   int
+	Duration time.Duration
+	Err      string
+	At       time.Time
+}
+
+// RequestJournal is a ready-made request/response hook pair (see
+// SetRequestHook/SetResponseHook) that records recent exchanges for a
+// session-scoped "requests" panel, so a user debugging a stuck or failing
+// search can see exactly what was sent and what came back without turning
+// on SetHTTPDump's full body capture. Safe for concurrent use.
+type RequestJournal struct {
+	mu      sync.Mutex
+	entries []JournalEntry
+}
+
+// NewRequestJournal returns a RequestJournal ready to back
+// SetRequestHook/SetResponseHook.
+func NewRequestJournal() *RequestJournal {
+	return &RequestJournal{}
+}
+
+// RequestHook returns the RequestHook to pass to SetRequestHook. It's a
+// no-op: a journal entry is only recorded once the exchange completes, so
+// RequestHook exists solely to satisfy the SetRequestHook/SetResponseHook
+// pairing other hook providers (e.g. StatsCollector) follow.
+func (j *RequestJournal) RequestHook() RequestHook {
+	return func(req *http.Request) {}
+}
+
+// ResponseHook returns the ResponseHook to pass to SetResponseHook.
+func (j *RequestJournal) ResponseHook() ResponseHook {
+	return func(req *http.Request, resp *http.Response, d time.Duration, err error) {
+		entry := JournalEntry{
+			Method:   req.Method,
+			URL:      req.URL.String(),
+			Duration: d,
+			At:       time.Now(),
+		}
+		if err != nil {
+			entry.Err = err.Error()
+		} else {
+			entry.Status = resp.StatusCode
+		}
+
+		j.mu.Lock()
+		defer j.mu.Unlock()
+		j.entries = append(j.entries, entry)
+		if len(j.entries) > journalCapacity {
+			j.entries = j.entries[len(j.entries)-journalCapacity:]
+		}
+	}
+}
+
+// Entries returns a snapshot of recorded exchanges, most recent last.
+func (j *RequestJournal) Entries() []JournalEntry {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return append([]JournalEntry(nil), j.entries...)
+}
+
+// Clear discards every recorded exchange.
+func (j *RequestJournal) Clear() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.entries = nil
+}
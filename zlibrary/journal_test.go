@@ -0,0 +1,91 @@
+package zlibrary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestJournalRecordsSuccessAndError(t *testing.T) {
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer ok.Close()
+
+	c := NewClient()
+	journal := NewRequestJournal()
+	c.SetRequestHook(journal.RequestHook())
+	c.SetResponseHook(journal.ResponseHook())
+
+	resp, err := c.MakeRequest(context.Background(), http.MethodGet, ok.URL, nil)
+	if err != nil {
+		t.Fatalf("MakeRequest: %v", err)
+	}
+	resp.Body.Close()
+
+	if _, err := c.MakeRequest(context.Background(), http.MethodGet, "http://127.0.0.1:0", nil); err == nil {
+		t.Fatal("MakeRequest err = nil, want a transport error")
+	}
+
+	entries := journal.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(entries))
+	}
+	if entries[0].Status != http.StatusOK || entries[0].Err != "" {
+		t.Errorf("entries[0] = %+v, want a 200 with no error", entries[0])
+	}
+	if entries[1].Err == "" {
+		t.Errorf("entries[1] = %+v, want a non-empty Err", entries[1])
+	}
+	if entries[1].URL == "" {
+		t.Error("entries[1].URL is empty, want the request URL even on a transport error")
+	}
+}
+
+func TestRequestJournalCapsAtCapacity(t *testing.T) {
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer ok.Close()
+
+	c := NewClient()
+	journal := NewRequestJournal()
+	c.SetRequestHook(journal.RequestHook())
+	c.SetResponseHook(journal.ResponseHook())
+
+	for i := 0; i < journalCapacity+10; i++ {
+		resp, err := c.MakeRequest(context.Background(), http.MethodGet, ok.URL, nil)
+		if err != nil {
+			t.Fatalf("MakeRequest: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if got := len(journal.Entries()); got != journalCapacity {
+		t.Errorf("got %d entries, want %d", got, journalCapacity)
+	}
+}
+
+func TestRequestJournalClear(t *testing.T) {
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer ok.Close()
+
+	c := NewClient()
+	journal := NewRequestJournal()
+	c.SetRequestHook(journal.RequestHook())
+	c.SetResponseHook(journal.ResponseHook())
+
+	resp, err := c.MakeRequest(context.Background(), http.MethodGet, ok.URL, nil)
+	if err != nil {
+		t.Fatalf("MakeRequest: %v", err)
+	}
+	resp.Body.Close()
+
+	journal.Clear()
+	if got := len(journal.Entries()); got != 0 {
+		t.Errorf("got %d entries after Clear, want 0", got)
+	}
+}
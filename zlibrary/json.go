@@ -0,0 +1,30 @@
+package zlibrary
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// DetailsSchemaVersion identifies the shape of the object ExportDetailsJSON
+// writes. It only changes when a field is renamed or removed outright -
+// adding a new optional field does not require a bump, since existing
+// consumers can keep ignoring fields they don't recognize.
+const DetailsSchemaVersion = 1
+
+// detailsDocument wraps a BookDetails with the schema version consumers
+// should check before relying on field names, keeping the payload stable
+// even as BookDetails itself grows new fields over time.
+type detailsDocument struct {
+	SchemaVersion int `json:"schema_version"`
+	*BookDetails
+}
+
+// ExportDetailsJSON writes d as a stable, versioned JSON document -
+// suitable for another program to parse, unlike the free-form Markdown and
+// plain-text exports which are meant for humans. Field names and their
+// json tags are part of the public API; see DetailsSchemaVersion.
+func ExportDetailsJSON(d *BookDetails, w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(detailsDocument{SchemaVersion: DetailsSchemaVersion, BookDetails: d})
+}
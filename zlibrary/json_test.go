@@ -0,0 +1,69 @@
+package zlibrary
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestExportDetailsJSONRoundTrips(t *testing.T) {
+	pages := "412"
+	details := &BookDetails{
+		BookSearchResult: BookSearchResult{Title: "Dune", Author: "Frank Herbert", DetailsURL: "/book/1"},
+		Description:      "A desert planet.",
+		Publisher:        "Ace Books",
+		ISBN13:           "9780441013593",
+		Pages:            &pages,
+		Availability:     AvailabilityAvailable,
+	}
+
+	var buf strings.Builder
+	if err := ExportDetailsJSON(details, &buf); err != nil {
+		t.Fatalf("ExportDetailsJSON: %v", err)
+	}
+
+	var decoded struct {
+		SchemaVersion int     `json:"schema_version"`
+		Title         string  `json:"title"`
+		Author        string  `json:"author"`
+		ISBN13        string  `json:"isbn13"`
+		Pages         *string `json:"pages"`
+		Availability  string  `json:"availability"`
+	}
+	if err := json.Unmarshal([]byte(buf.String()), &decoded); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+
+	if decoded.SchemaVersion != DetailsSchemaVersion {
+		t.Errorf("schema_version = %d, want %d", decoded.SchemaVersion, DetailsSchemaVersion)
+	}
+	if decoded.Title != "Dune" || decoded.Author != "Frank Herbert" {
+		t.Errorf("unexpected flattened fields: %+v", decoded)
+	}
+	if decoded.ISBN13 != "9780441013593" {
+		t.Errorf("isbn13 = %q", decoded.ISBN13)
+	}
+	if decoded.Pages == nil || *decoded.Pages != "412" {
+		t.Errorf("pages = %v", decoded.Pages)
+	}
+	if decoded.Availability != string(AvailabilityAvailable) {
+		t.Errorf("availability = %q", decoded.Availability)
+	}
+}
+
+func TestExportDetailsJSONOmitsBlankOptionalFields(t *testing.T) {
+	details := &BookDetails{
+		BookSearchResult: BookSearchResult{Title: "Untitled"},
+	}
+
+	var buf strings.Builder
+	if err := ExportDetailsJSON(details, &buf); err != nil {
+		t.Fatalf("ExportDetailsJSON: %v", err)
+	}
+
+	for _, field := range []string{`"series"`, `"edition"`, `"categories"`, `"ipfs_cid"`} {
+		if strings.Contains(buf.String(), field) {
+			t.Errorf("expected %s to be omitted for a book with no data, got: %s", field, buf.String())
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package zlibrary
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// jsonLDBook is the subset of a schema.org Book's JSON-LD fields we care
+// about. Author and NumberOfPages are left as raw JSON because sites are
+// inconsistent about encoding them as a string versus a nested object or
+// number.
+type jsonLDBook struct {
+	Name          string          `json:"name"`
+	Author        json.RawMessage `json:"author"`
+	ISBN          string          `json:"isbn"`
+	InLanguage    string          `json:"inLanguage"`
+	NumberOfPages json.RawMessage `json:"numberOfPages"`
+	Image         string          `json:"image"`
+}
+
+func (b jsonLDBook) authorName() string {
+	if len(b.Author) == 0 {
+		return ""
+	}
+	var asString string
+	if err := json.Unmarshal(b.Author, &asString); err == nil {
+		return asString
+	}
+	var asObject struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(b.Author, &asObject); err == nil {
+		return asObject.Name
+	}
+	return ""
+}
+
+func (b jsonLDBook) pagesString() string {
+	if len(b.NumberOfPages) == 0 {
+		return ""
+	}
+	var asString string
+	if err := json.Unmarshal(b.NumberOfPages, &asString); err == nil {
+		return asString
+	}
+	var asNumber json.Number
+	if err := json.Unmarshal(b.NumberOfPages, &asNumber); err == nil {
+		return asNumber.String()
+	}
+	return ""
+}
+
+// applyJSONLDFallback fills in whatever fields the selector-based
+// extraction left empty on details from the page's embedded schema.org
+// Book JSON-LD block, if one is present. Detail pages that lack the block,
+// or whose JSON-LD doesn't describe a Book, are left untouched. Malformed
+// JSON is ignored rather than treated as an error, since this is purely a
+// best-effort supplement to the selector-based scrape.
+func applyJSONLDFallback(details *BookDetails, doc *goquery.Document) {
+	doc.Find(`script[type="application/ld+json"]`).EachWithBreak(func(_ int, s *goquery.Selection) bool {
+		var raw map[string]any
+		if err := json.Unmarshal([]byte(s.Text()), &raw); err != nil {
+			return true
+		}
+		if t, _ := raw["@type"].(string); !strings.EqualFold(t, "Book") {
+			return true
+		}
+
+		data, err := json.Marshal(raw)
+		if err != nil {
+			return true
+		}
+		var book jsonLDBook
+		if err := json.Unmarshal(data, &book); err != nil {
+			return true
+		}
+
+		fillFromJSONLD(details, book)
+		return false
+	})
+}
+
+func fillFromJSONLD(details *BookDetails, book jsonLDBook) {
+	if details.Title == "" {
+		details.Title = book.Name
+	}
+	if details.Author == "" {
+		details.Author = book.authorName()
+		if details.Author != "" {
+			details.Authors = []Author{{Name: details.Author}}
+		}
+	}
+	if details.ISBN13 == "" {
+		details.ISBN13 = book.ISBN
+	}
+	if details.Language == "" {
+		details.Language = book.InLanguage
+	}
+	if details.CoverURL == "" {
+		details.CoverURL = book.Image
+	}
+	if details.Pages == nil {
+		if pages := book.pagesString(); pages != "" {
+			details.Pages = &pages
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package zlibrary
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestApplyJSONLDFallbackFillsMissingFields(t *testing.T) {
+	// Deliberately has none of the selectors getBookDetailsUncached looks
+	// for, to simulate a page redesign breaking the scrape, with only the
+	// JSON-LD block intact.
+	const html = `<html><body>
+		<script type="application/ld+json">
+		{
+			"@type": "Book",
+			"name": "The Example Book",
+			"author": {"name": "Jane Doe"},
+			"isbn": "9781234567890",
+			"inLanguage": "en",
+			"numberOfPages": 321,
+			"image": "https://covers.example.com/cover.jpg"
+		}
+		</script>
+	</body></html>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+
+	details := &BookDetails{}
+	applyJSONLDFallback(details, doc)
+
+	if details.Title != "The Example Book" {
+		t.Errorf("Title = %q", details.Title)
+	}
+	if details.Author != "Jane Doe" {
+		t.Errorf("Author = %q", details.Author)
+	}
+	if details.ISBN13 != "9781234567890" {
+		t.Errorf("ISBN13 = %q", details.ISBN13)
+	}
+	if details.Language != "en" {
+		t.Errorf("Language = %q", details.Language)
+	}
+	if details.CoverURL != "https://covers.example.com/cover.jpg" {
+		t.Errorf("CoverURL = %q", details.CoverURL)
+	}
+	if details.Pages == nil || *details.Pages != "321" {
+		t.Errorf("Pages = %v", details.Pages)
+	}
+}
+
+func TestApplyJSONLDFallbackIgnoresMalformedJSON(t *testing.T) {
+	const html = `<html><body>
+		<script type="application/ld+json">{ this is not valid json </script>
+	</body></html>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+
+	details := &BookDetails{}
+	applyJSONLDFallback(details, doc) // must not panic or return an error
+
+	if details.Title != "" {
+		t.Errorf("Title = %q, want empty", details.Title)
+	}
+}
@@ -0,0 +1,49 @@
+package zlibrary
+
+import (
+	"os"
+	"strings"
+)
+
+// defaultLanguage is used when the OS locale can't be determined.
+const defaultLanguage = "en"
+
+// SetLanguage sets the Accept-Language header and the site's interface-
+// language cookie sent with every request, for users who get better
+// search ranking and localized category/property labels on a non-English
+// page. Z-Library's own markup keeps the same CSS classes regardless of
+// display language, so this doesn't change how details pages are parsed -
+// only which language they're rendered (and ranked) in.
+func (c *Client) SetLanguage(code string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.language = code
+}
+
+// Language returns the language code currently in use.
+func (c *Client) Language() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.language
+}
+
+// osLanguage reads the OS locale from the standard POSIX locale
+// environment variables, falling back to defaultLanguage if none are set
+// or the value can't be parsed. It only looks at the language subtag
+// (e.g. "ru" out of "ru_RU.UTF-8"), since that's all SetLanguage needs.
+func osLanguage() string {
+	for _, env := range []string{"LC_ALL", "LANG", "LANGUAGE"} {
+		v := os.Getenv(env)
+		if v == "" {
+			continue
+		}
+		v = strings.SplitN(v, ".", 2)[0]
+		v = strings.SplitN(v, "_", 2)[0]
+		v = strings.SplitN(v, "-", 2)[0]
+		v = strings.ToLower(strings.TrimSpace(v))
+		if v != "" && v != "c" && v != "posix" {
+			return v
+		}
+	}
+	return defaultLanguage
+}
@@ -0,0 +1,87 @@
+package zlibrary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewClientDefaultsLanguageFromOSLocale(t *testing.T) {
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LANGUAGE", "")
+	t.Setenv("LANG", "ru_RU.UTF-8")
+
+	c := NewClient()
+	if got := c.Language(); got != "ru" {
+		t.Errorf("Language() = %q, want %q", got, "ru")
+	}
+}
+
+func TestSetLanguageSendsAcceptLanguageAndCookie(t *testing.T) {
+	var gotAcceptLanguage, gotCookie string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAcceptLanguage = r.Header.Get("Accept-Language")
+		if cookie, err := r.Cookie("language"); err == nil {
+			gotCookie = cookie.Value
+		}
+		w.Write([]byte(`<html><body></body></html>`))
+	}))
+	defer server.Close()
+
+	c := NewClient()
+	c.SetBaseURL(server.URL)
+	c.SetLanguage("ru")
+
+	if _, err := c.GetBookDetails(context.Background(), "/book/123"); err != nil {
+		t.Fatalf("GetBookDetails: %v", err)
+	}
+	if gotAcceptLanguage != "ru" {
+		t.Errorf("Accept-Language = %q, want %q", gotAcceptLanguage, "ru")
+	}
+	if gotCookie != "ru" {
+		t.Errorf("language cookie = %q, want %q", gotCookie, "ru")
+	}
+}
+
+// TestGetBookDetailsParsesRussianLabeledPage confirms that propertyValue's
+// class-based selectors extract correctly regardless of display language -
+// no localized-label lookup table is needed. See the comment on
+// propertyValue in details.go.
+func TestGetBookDetailsParsesRussianLabeledPage(t *testing.T) {
+	page := `<html><body>
+		<h1 itemprop="name">Война и мир</h1>
+		<div class="authors"><a>Лев Толстой</a></div>
+		<div class="book-property__publisher">
+			<div class="property_label">Издатель</div>
+			<div class="property_value">Эксмо</div>
+		</div>
+		<div class="book-property__isbn">
+			<div class="property_label">ISBN</div>
+			<div class="property_value">978-5-699-00000-5</div>
+		</div>
+	</body></html>`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(page))
+	}))
+	defer server.Close()
+
+	c := NewClient()
+	c.SetBaseURL(server.URL)
+	c.SetLanguage("ru")
+
+	d, err := c.GetBookDetails(context.Background(), "/book/123")
+	if err != nil {
+		t.Fatalf("GetBookDetails: %v", err)
+	}
+	if d.Title != "Война и мир" {
+		t.Errorf("Title = %q", d.Title)
+	}
+	if d.Publisher != "Эксмо" {
+		t.Errorf("Publisher = %q", d.Publisher)
+	}
+	if d.ISBN13 != "9785699000005" {
+		t.Errorf("ISBN13 = %q", d.ISBN13)
+	}
+}
@@ -0,0 +1,173 @@
+package zlibrary
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"github.com/Advik-B/The-Blackbook-Archive/utils"
+)
+
+// ExportMarkdown writes results as a Markdown table - title (linked to its
+// DetailsURL), author, year, format, and size columns - suitable for
+// pasting into notes or sharing a reading list. Fields left blank by the
+// scraper render as blank cells rather than placeholder text.
+func ExportMarkdown(results []BookSearchResult, w io.Writer) error {
+	if _, err := io.WriteString(w, "| Title | Author | Year | Format | Size |\n"); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, "| --- | --- | --- | --- | --- |\n"); err != nil {
+		return err
+	}
+
+	for _, r := range results {
+		title := escapeMarkdownPipes(r.Title)
+		if r.DetailsURL != "" {
+			title = fmt.Sprintf("[%s](%s)", title, r.DetailsURL)
+		}
+		line := fmt.Sprintf("| %s | %s | %s | %s | %s |\n",
+			title, escapeMarkdownPipes(utils.FormatAuthors(r.Author)), r.Year, r.Format, r.SizeText)
+		if _, err := io.WriteString(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func escapeMarkdownPipes(s string) string {
+	return strings.ReplaceAll(s, "|", "\\|")
+}
+
+// detailsMetadata lists a book's metadata fields in display order,
+// skipping anything the scraper left blank (or, for Pages, nil) - shared
+// between ExportDetailsMarkdown and ExportDetailsText so the two formats
+// can't drift apart on which fields they show.
+func detailsMetadata(d *BookDetails) [][2]string {
+	var rows [][2]string
+	add := func(label, value string) {
+		if value != "" {
+			rows = append(rows, [2]string{label, value})
+		}
+	}
+
+	add("Publisher", d.Publisher)
+	add("Year", d.Year)
+	if d.Pages != nil {
+		add("Pages", *d.Pages)
+	}
+	add("Edition", d.Edition)
+	add("ISBN-10", d.ISBN10)
+	add("ISBN-13", d.ISBN13)
+	if d.Series != "" {
+		series := d.Series
+		if d.SeriesIndex != nil {
+			series = fmt.Sprintf("%s (Book %s)", series, *d.SeriesIndex)
+		}
+		add("Series", series)
+	}
+	add("Format", d.Format)
+	add("Language", d.Language)
+
+	return rows
+}
+
+// normalizeDescription cleans up a scraped description for export: any
+// HTML entities the markup left un-decoded (e.g. "&amp;") are resolved, and
+// runs of three or more newlines - an artifact of some mirrors' markup -
+// collapse to a single blank line between paragraphs.
+func normalizeDescription(description string) string {
+	text := html.UnescapeString(description)
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	for strings.Contains(text, "\n\n\n") {
+		text = strings.ReplaceAll(text, "\n\n\n", "\n\n")
+	}
+	return strings.TrimSpace(text)
+}
+
+// ExportDetailsMarkdown writes a tidy Markdown document for a single book -
+// its title as a heading, a metadata table, the description, its category
+// list, and links to its details and download pages - suitable for pasting
+// into notes. Fields the scraper left blank are omitted rather than shown
+// empty.
+func ExportDetailsMarkdown(d *BookDetails, w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n\n", d.Title)
+	if d.Author != "" {
+		fmt.Fprintf(&b, "By %s\n\n", utils.FormatAuthors(d.Author))
+	}
+
+	if rows := detailsMetadata(d); len(rows) > 0 {
+		b.WriteString("| Field | Value |\n| --- | --- |\n")
+		for _, row := range rows {
+			fmt.Fprintf(&b, "| %s | %s |\n", row[0], escapeMarkdownPipes(row[1]))
+		}
+		b.WriteString("\n")
+	}
+
+	if d.Description != "" {
+		fmt.Fprintf(&b, "%s\n\n", normalizeDescription(d.Description))
+	}
+
+	if len(d.Categories) > 0 {
+		b.WriteString("Categories:\n")
+		for _, cat := range d.Categories {
+			fmt.Fprintf(&b, "- %s\n", cat.Name)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("Links:\n")
+	if d.DetailsURL != "" {
+		fmt.Fprintf(&b, "- [Details](%s)\n", d.DetailsURL)
+	}
+	if d.DownloadURL != "" {
+		fmt.Fprintf(&b, "- [Download](%s)\n", d.DownloadURL)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// ExportDetailsText is the plain-text equivalent of ExportDetailsMarkdown,
+// for pasting somewhere Markdown wouldn't render - no headings, tables, or
+// links, just labeled lines.
+func ExportDetailsText(d *BookDetails, w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s\n", d.Title)
+	if d.Author != "" {
+		fmt.Fprintf(&b, "By %s\n", utils.FormatAuthors(d.Author))
+	}
+	b.WriteString("\n")
+
+	for _, row := range detailsMetadata(d) {
+		fmt.Fprintf(&b, "%s: %s\n", row[0], row[1])
+	}
+	b.WriteString("\n")
+
+	if d.Description != "" {
+		fmt.Fprintf(&b, "%s\n\n", normalizeDescription(d.Description))
+	}
+
+	if len(d.Categories) > 0 {
+		b.WriteString("Categories: ")
+		names := make([]string, len(d.Categories))
+		for i, cat := range d.Categories {
+			names[i] = cat.Name
+		}
+		b.WriteString(strings.Join(names, ", "))
+		b.WriteString("\n\n")
+	}
+
+	if d.DetailsURL != "" {
+		fmt.Fprintf(&b, "Details: %s\n", d.DetailsURL)
+	}
+	if d.DownloadURL != "" {
+		fmt.Fprintf(&b, "Download: %s\n", d.DownloadURL)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
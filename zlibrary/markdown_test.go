@@ -0,0 +1,77 @@
+package zlibrary
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExportMarkdownLinksTitlesAndEscapesPipes(t *testing.T) {
+	results := []BookSearchResult{
+		{Title: "Dune | Extended", Author: "Frank Herbert", Year: "1965", Format: "EPUB", SizeText: "2.1 MB", DetailsURL: "/book/1"},
+		{Title: "Untitled"},
+	}
+
+	var buf strings.Builder
+	if err := ExportMarkdown(results, &buf); err != nil {
+		t.Fatalf("ExportMarkdown: %v", err)
+	}
+	out := buf.String()
+
+	if !strings.Contains(out, "[Dune \\| Extended](/book/1)") {
+		t.Errorf("output missing escaped, linked title: %q", out)
+	}
+	if !strings.Contains(out, "| Untitled |  |  |  |  |") {
+		t.Errorf("output missing blank cells for optional fields: %q", out)
+	}
+}
+
+func TestExportDetailsMarkdownSkipsBlankFieldsAndNormalizesDescription(t *testing.T) {
+	pages := "412"
+	details := &BookDetails{
+		BookSearchResult: BookSearchResult{Title: "Dune", Author: "Frank Herbert", DetailsURL: "/book/1"},
+		Description:      "A desert planet.\r\n\r\n\r\nHouse Atreides &amp; House Harkonnen.",
+		Publisher:        "Ace Books",
+		Pages:            &pages,
+	}
+
+	var buf strings.Builder
+	if err := ExportDetailsMarkdown(details, &buf); err != nil {
+		t.Fatalf("ExportDetailsMarkdown: %v", err)
+	}
+	out := buf.String()
+
+	if !strings.Contains(out, "# Dune") {
+		t.Errorf("output missing title heading: %q", out)
+	}
+	if !strings.Contains(out, "| Pages | 412 |") {
+		t.Errorf("output missing pages row: %q", out)
+	}
+	if strings.Contains(out, "| Series |") {
+		t.Errorf("output should skip the blank series row: %q", out)
+	}
+	if !strings.Contains(out, "House Atreides & House Harkonnen.") {
+		t.Errorf("output should decode HTML entities: %q", out)
+	}
+	if strings.Contains(out, "\n\n\n") {
+		t.Errorf("output should collapse runs of blank lines: %q", out)
+	}
+}
+
+func TestExportDetailsTextOmitsMarkdownSyntax(t *testing.T) {
+	details := &BookDetails{
+		BookSearchResult: BookSearchResult{Title: "Dune", DetailsURL: "/book/1"},
+	}
+
+	var buf strings.Builder
+	if err := ExportDetailsText(details, &buf); err != nil {
+		t.Fatalf("ExportDetailsText: %v", err)
+	}
+	out := buf.String()
+
+	if strings.Contains(out, "#") || strings.Contains(out, "|") {
+		t.Errorf("plain-text export should contain no Markdown syntax: %q", out)
+	}
+	if !strings.Contains(out, "Details: /book/1") {
+		t.Errorf("output missing details link line: %q", out)
+	}
+}
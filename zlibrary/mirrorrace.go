@@ -0,0 +1,168 @@
+package zlibrary
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// defaultMirrorRaceStagger is how long the second mirror waits before
+// starting, giving the primary a head start so a healthy connection
+// doesn't end up doubling its own request load on every search.
+const defaultMirrorRaceStagger = 3 * time.Second
+
+// SetMirrorRaceStagger overrides how long the candidate mirror waits
+// before starting, once mirror racing is enabled. Zero or negative resets
+// it to the default.
+func (c *Client) SetMirrorRaceStagger(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.mirrorRaceStagger = d
+}
+
+// mirrorRaceStaggerLocked returns the configured stagger, or the default
+// if unset. Callers must hold c.mu.
+func (c *Client) mirrorRaceStaggerLocked() time.Duration {
+	if c.mirrorRaceStagger <= 0 {
+		return defaultMirrorRaceStagger
+	}
+	return c.mirrorRaceStagger
+}
+
+// SetMirrorCandidates configures the extra mirror base URLs SearchZLibrary
+// may race the primary against when mirror racing is enabled (see
+// SetMirrorRacing). Only the first of these is actually used - racing more
+// than two mirrors at once isn't worth the extra load for how much it
+// would realistically save.
+func (c *Client) SetMirrorCandidates(urls []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.mirrorCandidates = urls
+}
+
+// SetMirrorRacing turns on racing the primary mirror against the first
+// configured mirror candidate for every search, taking whichever responds
+// first and promoting it to primary for the rest of the session. Off by
+// default - a user with a healthy connection to the primary gains nothing
+// from doubling their request load.
+func (c *Client) SetMirrorRacing(enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.mirrorRacingEnabled = enabled
+}
+
+// raceCandidates returns the primary mirror and, if racing is enabled and
+// at least one is configured, the first mirror candidate - nil if racing
+// shouldn't happen at all.
+func (c *Client) raceCandidates() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.mirrorRacingEnabled || len(c.mirrorCandidates) == 0 {
+		return nil
+	}
+	return []string{c.baseURL, c.mirrorCandidates[0]}
+}
+
+// cloneForMirror returns a new Client sharing c's underlying *http.Client
+// and header/format settings but pointed at baseURL, with its own
+// mutex-guarded referrer state - so racing two mirrors at once can't mix
+// up which one a given request's Referer was tracking.
+func (c *Client) cloneForMirror(baseURL string) *Client {
+	c.mu.Lock()
+	clone := &Client{
+		httpClient:       c.httpClient,
+		baseURL:          baseURL,
+		userAgent:        c.userAgent,
+		secChUA:          c.secChUA,
+		secChUAPlatform:  c.secChUAPlatform,
+		sendReferer:      c.sendReferer,
+		safeMode:         c.safeMode,
+		ipfsGateways:     c.ipfsGateways,
+		preferredFormats: c.preferredFormats,
+		language:         c.language,
+	}
+	c.mu.Unlock()
+	return clone
+}
+
+// mirrorRaceResult carries one racer's outcome back to searchRacingMirrors.
+type mirrorRaceResult struct {
+	baseURL string
+	results []BookSearchResult
+	err     error
+}
+
+// searchRacingMirrors runs query against the primary mirror and, after a
+// short stagger, the first configured candidate, returning whichever
+// mirror responds first with a successfully parsed page. The loser is
+// cancelled via ctx once a winner is found. A winning candidate mirror is
+// promoted to primary on c for the rest of the session.
+func (c *Client) searchRacingMirrors(ctx context.Context, query string, page int) ([]BookSearchResult, error) {
+	candidates := c.raceCandidates()
+	if len(candidates) < 2 {
+		return c.searchSingleMirror(ctx, c, query, page)
+	}
+
+	raceCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	c.mu.Lock()
+	stagger := c.mirrorRaceStaggerLocked()
+	c.mu.Unlock()
+
+	racers := []*Client{c, c.cloneForMirror(candidates[1])}
+	resultCh := make(chan mirrorRaceResult, len(racers))
+
+	for i, racer := range racers {
+		i, racer := i, racer
+		go func() {
+			if i > 0 {
+				timer := time.NewTimer(stagger)
+				defer timer.Stop()
+				select {
+				case <-timer.C:
+				case <-raceCtx.Done():
+					return
+				}
+			}
+			results, err := c.searchSingleMirror(raceCtx, racer, query, page)
+			select {
+			case resultCh <- mirrorRaceResult{baseURL: racer.BaseURL(), results: results, err: err}:
+			case <-raceCtx.Done():
+			}
+		}()
+	}
+
+	var firstErr error
+	for range racers {
+		res := <-resultCh
+		if res.err != nil {
+			if firstErr == nil {
+				firstErr = res.err
+			}
+			continue
+		}
+		cancel()
+		if res.baseURL != candidates[0] {
+			c.SetBaseURL(res.baseURL)
+		}
+		return res.results, nil
+	}
+	if firstErr == nil {
+		firstErr = errors.New("zlibrary: all mirrors failed")
+	}
+	return nil, firstErr
+}
+
+// searchSingleMirror drains racer's SearchZLibraryStream into a slice,
+// the same way the non-racing SearchZLibrary does for its one mirror.
+func (c *Client) searchSingleMirror(ctx context.Context, racer *Client, query string, page int) ([]BookSearchResult, error) {
+	var results []BookSearchResult
+	for result := range racer.SearchZLibraryStream(ctx, query, page) {
+		if result.Err != nil {
+			return results, result.Err
+		}
+		results = append(results, result.BookSearchResult)
+	}
+	return results, nil
+}
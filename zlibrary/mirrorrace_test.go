@@ -0,0 +1,65 @@
+package zlibrary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSearchZLibraryRacesAndPromotesFasterMirror(t *testing.T) {
+	book := `<html><body><div class="book-item"><a class="book-title" href="/book/1">Dune</a></div></body></html>`
+
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.Write([]byte(book))
+	}))
+	defer slow.Close()
+
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(book))
+	}))
+	defer fast.Close()
+
+	c := NewClient()
+	c.SetBaseURL(slow.URL)
+	c.SetMirrorCandidates([]string{fast.URL})
+	c.SetMirrorRacing(true)
+	c.SetMirrorRaceStagger(time.Millisecond) // avoid waiting out the real default in a unit test
+
+	if _, err := c.SearchZLibrary(context.Background(), "dune", 1); err != nil {
+		t.Fatalf("SearchZLibrary: %v", err)
+	}
+
+	if got := c.BaseURL(); got != fast.URL {
+		t.Errorf("BaseURL() = %q after racing, want the faster mirror %q", got, fast.URL)
+	}
+}
+
+func TestSearchZLibraryDoesNotRaceWhenDisabled(t *testing.T) {
+	called := false
+	candidate := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer candidate.Close()
+
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><body></body></html>`))
+	}))
+	defer primary.Close()
+
+	c := NewClient()
+	c.SetBaseURL(primary.URL)
+	c.SetMirrorCandidates([]string{candidate.URL})
+
+	if _, err := c.SearchZLibrary(context.Background(), "dune", 1); err != nil {
+		t.Fatalf("SearchZLibrary: %v", err)
+	}
+	if called {
+		t.Error("candidate mirror was contacted despite racing being disabled")
+	}
+	if got := c.BaseURL(); got != primary.URL {
+		t.Errorf("BaseURL() = %q, want unchanged primary %q", got, primary.URL)
+	}
+}
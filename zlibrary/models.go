@@ -0,0 +1,158 @@
+package zlibrary
+
+// SourceName identifies this package to callers that juggle multiple book
+// sources, matching the Source field stamped onto every result it produces.
+const SourceName = "Z-Library"
+
+// BookSearchResult is a single row of a Z-Library search result page. It
+// carries only the fields that are cheap to scrape from the listing itself;
+// anything that requires visiting the book's own page lives on BookDetails.
+type BookSearchResult struct {
+	// Source identifies which provider produced this result (e.g.
+	// "Z-Library" or "Library Genesis"), so callers juggling multiple
+	// sources can route a details/download request back to the right one.
+	Source string `json:"source"`
+
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Author string `json:"author"`
+
+	// Authors is Author broken out into one entry per credited author,
+	// each with a link to their author page when the scraped markup gave
+	// it one. Author stays populated (joined from these, or the first
+	// one) for callers that only care about a single display string.
+	Authors []Author `json:"authors,omitempty"`
+
+	Year       string `json:"year"`
+	Language   string `json:"language"`
+	Format     string `json:"format"`
+	SizeText   string `json:"size_text"`
+	Rating     string `json:"rating"`
+	CoverURL   string `json:"cover_url"`
+	DetailsURL string `json:"details_url"`
+}
+
+// FormatInfo describes one of the alternate download formats offered on a
+// book's details page, alongside the primary Format/DownloadURL pair.
+type FormatInfo struct {
+	Format   string `json:"format"`
+	SizeText string `json:"size_text"`
+	URL      string `json:"url"`
+
+	// ConversionOnly marks a format some mirrors list but don't serve
+	// directly - it has to be produced locally (e.g. via Calibre) from
+	// another format that is actually downloadable.
+	ConversionOnly bool `json:"conversion_only"`
+
+	// Size is this format's size as shown on the page (e.g. "4.2 MB"),
+	// when the markup carries one. nil when it doesn't - callers that need
+	// a size regardless can fall back to a HEAD preflight against URL.
+	Size *string `json:"size,omitempty"`
+
+	// SizeBytes is Size parsed into bytes, or 0 if Size is nil or couldn't
+	// be parsed.
+	SizeBytes int64 `json:"size_bytes"`
+}
+
+// Author is one author credited on a book, with a link to their author
+// page when the scraped markup linked one. A book with no links for its
+// authors (just a plain-text credit line) still produces Authors entries,
+// each with a nil URL.
+type Author struct {
+	Name string  `json:"name"`
+	URL  *string `json:"url,omitempty"`
+}
+
+// Category is one entry in a book's category breadcrumb. Parent holds the
+// name of the category one level up the trail, or nil for a top-level
+// category, so the UI can render the hierarchy (or just the flat Name/URL,
+// for callers that don't care about it).
+type Category struct {
+	Name   string  `json:"name"`
+	URL    string  `json:"url"`
+	Parent *string `json:"parent,omitempty"`
+}
+
+// Availability summarizes whether a book's details page actually offers a
+// download, and if not, why - distinguishing "the scraper couldn't find a
+// download button" (Unknown) from the page explicitly saying so.
+type Availability string
+
+const (
+	AvailabilityAvailable     Availability = "available"
+	AvailabilityPremiumOnly   Availability = "premium_only"
+	AvailabilityRegionBlocked Availability = "region_blocked"
+	AvailabilityRemoved       Availability = "removed"
+	AvailabilityUnknown       Availability = "unknown"
+)
+
+// BookDetails holds everything scraped from a book's own page, in addition
+// to the summary fields already known from the search result that led here.
+type BookDetails struct {
+	BookSearchResult
+
+	Description string `json:"description"`
+
+	// DescriptionRich is Description broken into paragraphs/list items with
+	// bold/italic emphasis preserved, for renderers that can show more than
+	// plain text. Nil when the description markup carried no structure
+	// (or couldn't be parsed) - Description remains the field to use for
+	// export and search either way.
+	DescriptionRich []DescriptionBlock `json:"description_rich,omitempty"`
+
+	Publisher string `json:"publisher"`
+	ISBN10    string `json:"isbn10"`
+	ISBN13    string `json:"isbn13"`
+
+	OtherFormats []FormatInfo `json:"other_formats,omitempty"`
+	DownloadURL  string       `json:"download_url"`
+
+	// Availability summarizes why a book can or can't be downloaded right
+	// now, beyond the bare presence or absence of DownloadURL. AvailabilityNotice
+	// carries the page's own wording for the non-Available cases, for
+	// display instead of a generic message.
+	Availability       Availability `json:"availability"`
+	AvailabilityNotice string       `json:"availability_notice,omitempty"`
+
+	// Pages is the book's page count, when known. It's a pointer rather
+	// than a plain string because one of its sources is the optional
+	// JSON-LD block - nil means "not known", distinct from "known to be
+	// empty".
+	Pages *string `json:"pages,omitempty"`
+
+	// Edition is the printing/edition label shown on the properties
+	// block (e.g. "2nd", "Revised"), when present.
+	Edition string `json:"edition,omitempty"`
+
+	// Series is the name of the series this book belongs to, if any.
+	Series string `json:"series,omitempty"`
+
+	// SeriesIndex is this book's position within Series (e.g. "3" for
+	// "Book 3"), letting a series listing be sorted sensibly. nil when
+	// the book isn't part of a series, or its position isn't stated.
+	SeriesIndex *string `json:"series_index,omitempty"`
+
+	// SeriesIndexNumeric is SeriesIndex parsed as a number, for sorting
+	// and metadata export (e.g. Calibre's series_index) that need an
+	// actual ordinal rather than display text. nil whenever SeriesIndex
+	// is nil or isn't purely numeric (e.g. "3a" or a roman numeral).
+	SeriesIndexNumeric *float64 `json:"series_index_numeric,omitempty"`
+
+	// SeriesURL is the listing page for every volume in Series, suitable
+	// for Client.GetSeriesBooks. nil when the book isn't part of a series.
+	SeriesURL *string `json:"series_url,omitempty"`
+
+	// Categories lists this book's categories, in breadcrumb order where
+	// the site presents a parent>child trail (Category.Parent links a
+	// child back to its parent's Name). A category with no stated parent
+	// is top-level.
+	Categories []Category `json:"categories,omitempty"`
+
+	// IpfsCID and IpfsCIDBlake2b are this book's content identifiers on
+	// IPFS, when the page publishes them - a sha256- and a blake2b-keyed
+	// CID for the same content, respectively. Empty when the book isn't
+	// mirrored there. See Client.IPFSGatewayURLs to turn either into a
+	// fetchable URL.
+	IpfsCID        string `json:"ipfs_cid,omitempty"`
+	IpfsCIDBlake2b string `json:"ipfs_cid_blake2b,omitempty"`
+}
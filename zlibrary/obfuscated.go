@@ -0,0 +1,57 @@
+package zlibrary
+
+import (
+	"encoding/base64"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// decodeObfuscatedDownloadLink recovers the primary download URL on pages
+// where it isn't exposed as a plain href, but instead as a base64-encoded
+// token in a data attribute (data-href, data-download) or inline in a
+// small <script> block assigning it to a JS variable. It's a fallback,
+// tried only once the plain href-based extraction in getBookDetailsUncached
+// comes up empty.
+func decodeObfuscatedDownloadLink(doc *goquery.Document) string {
+	for _, attr := range []string{"data-href", "data-download"} {
+		if encoded, ok := doc.Find("a[" + attr + "]").First().Attr(attr); ok && encoded != "" {
+			if decoded := decodeBase64Token(encoded); decoded != "" {
+				return decoded
+			}
+		}
+	}
+
+	var fromScript string
+	doc.Find("script").EachWithBreak(func(_ int, s *goquery.Selection) bool {
+		match := downloadVarRe.FindStringSubmatch(s.Text())
+		if match == nil {
+			return true
+		}
+		fromScript = match[1]
+		return false
+	})
+
+	return fromScript
+}
+
+var downloadVarRe = regexp.MustCompile(`(?:var|let|const)\s+downloadUrl\s*=\s*["']([^"']+)["']`)
+
+// decodeBase64Token decodes s as base64 (standard or URL-safe, padded or
+// not) and returns the result only if it looks like a URL path - a data
+// attribute that happens to contain base64-looking text but decodes to
+// garbage shouldn't be trusted as a download link.
+func decodeBase64Token(s string) string {
+	for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.URLEncoding, base64.RawStdEncoding, base64.RawURLEncoding} {
+		decoded, err := enc.DecodeString(s)
+		if err != nil {
+			continue
+		}
+		text := string(decoded)
+		if strings.HasPrefix(text, "/") || strings.HasPrefix(text, "http") {
+			return text
+		}
+	}
+	return ""
+}
@@ -0,0 +1,46 @@
+package zlibrary
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestDecodeObfuscatedDownloadLinkFromDataAttribute(t *testing.T) {
+	token := base64.StdEncoding.EncodeToString([]byte("/dl/12345/deadbeef"))
+	html := `<a class="someOtherLink" data-href="` + token + `">Download</a>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+
+	if got := decodeObfuscatedDownloadLink(doc); got != "/dl/12345/deadbeef" {
+		t.Errorf("decodeObfuscatedDownloadLink() = %q, want /dl/12345/deadbeef", got)
+	}
+}
+
+func TestDecodeObfuscatedDownloadLinkFromScriptVariable(t *testing.T) {
+	html := `<script>var downloadUrl = "/dl/99/cafef00d";</script>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+
+	if got := decodeObfuscatedDownloadLink(doc); got != "/dl/99/cafef00d" {
+		t.Errorf("decodeObfuscatedDownloadLink() = %q, want /dl/99/cafef00d", got)
+	}
+}
+
+func TestDecodeObfuscatedDownloadLinkEmptyWhenNoMarkup(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html></html>"))
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	if got := decodeObfuscatedDownloadLink(doc); got != "" {
+		t.Errorf("decodeObfuscatedDownloadLink() = %q, want empty", got)
+	}
+}
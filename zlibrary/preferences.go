@@ -0,0 +1,46 @@
+package zlibrary
+
+import "strings"
+
+// SetPreferredFormats sets an ordered list of formats (e.g. "EPUB", "PDF"),
+// most-preferred first, that PreferredDownloadFormat ranks a book's
+// available formats against. An empty list (the default) leaves format
+// selection at whatever the primary download link already resolved to.
+func (c *Client) SetPreferredFormats(formats []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.preferredFormats = formats
+}
+
+// PreferredDownloadFormat picks the best format to download for details,
+// ranking its primary Format and every non-ConversionOnly OtherFormats
+// entry against the preference list set by SetPreferredFormats and
+// returning the highest-ranked one that's actually available. Falls back
+// to details.Format when none of the preferred formats are available, or
+// no preference has been set.
+func (c *Client) PreferredDownloadFormat(details *BookDetails) string {
+	c.mu.Lock()
+	preferred := c.preferredFormats
+	c.mu.Unlock()
+
+	if len(preferred) == 0 {
+		return details.Format
+	}
+
+	available := make(map[string]string)
+	if details.DownloadURL != "" && details.Format != "" {
+		available[strings.ToUpper(details.Format)] = details.Format
+	}
+	for _, f := range details.OtherFormats {
+		if !f.ConversionOnly {
+			available[strings.ToUpper(f.Format)] = f.Format
+		}
+	}
+
+	for _, want := range preferred {
+		if actual, ok := available[strings.ToUpper(want)]; ok {
+			return actual
+		}
+	}
+	return details.Format
+}
@@ -0,0 +1,39 @@
+package zlibrary
+
+import "testing"
+
+func TestPreferredDownloadFormatRanksAvailableFormats(t *testing.T) {
+	details := &BookDetails{
+		BookSearchResult: BookSearchResult{Format: "PDF"},
+		DownloadURL:      "https://z-lib.io/dl/1",
+		OtherFormats: []FormatInfo{
+			{Format: "EPUB", URL: "https://z-lib.io/dl/1.epub"},
+			{Format: "MOBI", URL: "https://z-lib.io/dl/1.mobi", ConversionOnly: true},
+		},
+	}
+
+	c := NewClient()
+	c.SetPreferredFormats([]string{"mobi", "epub", "pdf"})
+
+	if got := c.PreferredDownloadFormat(details); got != "EPUB" {
+		t.Errorf("PreferredDownloadFormat() = %q, want EPUB (MOBI is conversion-only)", got)
+	}
+}
+
+func TestPreferredDownloadFormatFallsBackToPrimary(t *testing.T) {
+	details := &BookDetails{
+		BookSearchResult: BookSearchResult{Format: "PDF"},
+		DownloadURL:      "https://z-lib.io/dl/1",
+	}
+
+	c := NewClient()
+
+	if got := c.PreferredDownloadFormat(details); got != "PDF" {
+		t.Errorf("PreferredDownloadFormat() with no preference = %q, want PDF", got)
+	}
+
+	c.SetPreferredFormats([]string{"azw3"})
+	if got := c.PreferredDownloadFormat(details); got != "PDF" {
+		t.Errorf("PreferredDownloadFormat() with unavailable preference = %q, want PDF", got)
+	}
+}
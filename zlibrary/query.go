@@ -0,0 +1,56 @@
+package zlibrary
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// maxSearchQueryLength caps how long a query string SearchZLibrary and its
+// variants will send, in runes. The site truncates or rejects very long
+// queries anyway; capping client-side gives a clear error instead of a
+// confusing empty or malformed results page.
+const maxSearchQueryLength = 200
+
+// ErrEmptySearchQuery is returned when a query is empty, or made up
+// entirely of whitespace/punctuation and carries no searchable terms.
+var ErrEmptySearchQuery = errors.New("zlibrary: search query is empty")
+
+// ErrSearchQueryTooLong is returned when a query exceeds
+// maxSearchQueryLength runes.
+var ErrSearchQueryTooLong = errors.New("zlibrary: search query is too long")
+
+// onlyPunctuationRe matches a query with no letters or digits at all, so
+// it's rejected before ever reaching the site rather than coming back as a
+// confusing empty result set.
+var onlyPunctuationRe = regexp.MustCompile(`^[^\p{L}\p{N}]*$`)
+
+// advancedQueryOperatorRe matches the site's advanced search syntax, e.g.
+// `author:"Frank Herbert"` or `series:dune`. Queries using it are passed
+// through unmodified beyond whitespace trimming, since collapsing interior
+// spacing could change which side of a colon a token lands on.
+var advancedQueryOperatorRe = regexp.MustCompile(`\b(author|title|series|publisher|lang|year|extension):`)
+
+// normalizeSearchQuery trims, collapses whitespace, and validates query
+// before it's sent to the site. Queries using the advanced operator syntax
+// are passed through with only trimming applied.
+func normalizeSearchQuery(query string) (string, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return "", ErrEmptySearchQuery
+	}
+
+	if !advancedQueryOperatorRe.MatchString(query) {
+		query = strings.Join(strings.Fields(query), " ")
+	}
+
+	if onlyPunctuationRe.MatchString(query) {
+		return "", ErrEmptySearchQuery
+	}
+
+	if len([]rune(query)) > maxSearchQueryLength {
+		return "", ErrSearchQueryTooLong
+	}
+
+	return query, nil
+}
@@ -0,0 +1,48 @@
+package zlibrary
+
+import "testing"
+
+func TestNormalizeSearchQuery(t *testing.T) {
+	cases := []struct {
+		name    string
+		query   string
+		want    string
+		wantErr error
+	}{
+		{name: "trims and collapses whitespace", query: "  dune   messiah  ", want: "dune messiah"},
+		{name: "ampersand passes through", query: "fire & ice", want: "fire & ice"},
+		{name: "quoted phrase passes through", query: `"the dune series"`, want: `"the dune series"`},
+		{name: "unicode terms", query: "  Сто лет одиночества  ", want: "Сто лет одиночества"},
+		{name: "empty", query: "   ", wantErr: ErrEmptySearchQuery},
+		{name: "punctuation only", query: "!!! ???", wantErr: ErrEmptySearchQuery},
+		{name: "advanced operator syntax untouched", query: `author:"Frank Herbert"   series:dune`, want: `author:"Frank Herbert"   series:dune`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := normalizeSearchQuery(tc.query)
+			if tc.wantErr != nil {
+				if err != tc.wantErr {
+					t.Fatalf("normalizeSearchQuery(%q) err = %v, want %v", tc.query, err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeSearchQuery(%q) unexpected err: %v", tc.query, err)
+			}
+			if got != tc.want {
+				t.Errorf("normalizeSearchQuery(%q) = %q, want %q", tc.query, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeSearchQueryRejectsTooLong(t *testing.T) {
+	long := make([]byte, maxSearchQueryLength+1)
+	for i := range long {
+		long[i] = 'a'
+	}
+	if _, err := normalizeSearchQuery(string(long)); err != ErrSearchQueryTooLong {
+		t.Fatalf("normalizeSearchQuery(long) err = %v, want %v", err, ErrSearchQueryTooLong)
+	}
+}
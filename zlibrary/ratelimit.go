@@ -0,0 +1,72 @@
+package zlibrary
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// defaultRateLimitBackoff is used when a 429 response carries no Retry-After
+// header at all.
+const defaultRateLimitBackoff = 5 * time.Second
+
+// SetRateLimitCallback registers a function called whenever a request hits
+// a 429 and is about to wait out the site's Retry-After before retrying
+// once, so a caller (typically the UI) can surface "rate limited, waiting
+// Ns" instead of the request just appearing to hang. Passing nil disables
+// the callback.
+func (c *Client) SetRateLimitCallback(fn func(wait time.Duration)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onRateLimited = fn
+}
+
+func (c *Client) notifyRateLimited(wait time.Duration) {
+	c.mu.Lock()
+	cb := c.onRateLimited
+	c.mu.Unlock()
+	if cb != nil {
+		cb(wait)
+	}
+}
+
+// retryAfterDuration parses a Retry-After header in either of its two
+// allowed forms - a delta in seconds, or an HTTP-date to wait until - and
+// reports whether header carried a usable value at all.
+func retryAfterDuration(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(header); err == nil {
+		if secs < 0 {
+			secs = 0
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(header); err == nil {
+		wait := time.Until(t)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// retryRequest builds a fresh *http.Request for req's URL/method/headers,
+// re-materializing its body via GetBody if it had one - req.Body may
+// already be drained from the first attempt, so it can't just be reused.
+func retryRequest(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
+	return clone, nil
+}
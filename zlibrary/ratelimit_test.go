@@ -0,0 +1,68 @@
+package zlibrary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDoRetriesAfterRateLimit(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c := NewClient()
+
+	var notifiedWait time.Duration
+	var notified bool
+	c.SetRateLimitCallback(func(wait time.Duration) {
+		notified = true
+		notifiedWait = wait
+	})
+
+	resp, err := c.MakeRequest(context.Background(), http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("MakeRequest: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if requests != 2 {
+		t.Errorf("got %d requests, want 2 (original + one retry)", requests)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status %d, want 200", resp.StatusCode)
+	}
+	if !notified {
+		t.Error("rate limit callback was never called")
+	}
+	if notifiedWait != 0 {
+		t.Errorf("notified wait = %s, want 0 (Retry-After: 0)", notifiedWait)
+	}
+}
+
+func TestRetryAfterDuration(t *testing.T) {
+	if d, ok := retryAfterDuration("5"); !ok || d != 5*time.Second {
+		t.Errorf("seconds form: got %s, %v", d, ok)
+	}
+	if _, ok := retryAfterDuration(""); ok {
+		t.Error("empty header should report not-ok")
+	}
+	future := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	d, ok := retryAfterDuration(future)
+	if !ok {
+		t.Fatal("HTTP-date form should report ok")
+	}
+	if d <= 0 || d > 11*time.Second {
+		t.Errorf("HTTP-date form: got %s, want ~10s", d)
+	}
+}
@@ -0,0 +1,39 @@
+package zlibrary
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetBookDetailsReturnsErrBookRemovedOn404(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	c := NewClient()
+	c.SetBaseURL(server.URL)
+
+	_, err := c.GetBookDetails(context.Background(), "/book/123")
+	if !errors.Is(err, ErrBookRemoved) {
+		t.Fatalf("GetBookDetails() err = %v, want ErrBookRemoved", err)
+	}
+}
+
+func TestGetBookDetailsReturnsErrBookRemovedOnRemovedNoticeMarkup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><body><div id="bookRemovedNotice">This book has been removed.</div></body></html>`))
+	}))
+	defer server.Close()
+
+	c := NewClient()
+	c.SetBaseURL(server.URL)
+
+	_, err := c.GetBookDetails(context.Background(), "/book/123")
+	if !errors.Is(err, ErrBookRemoved) {
+		t.Fatalf("GetBookDetails() err = %v, want ErrBookRemoved", err)
+	}
+}
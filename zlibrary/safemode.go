@@ -0,0 +1,64 @@
+package zlibrary
+
+import (
+	"net/url"
+	"strings"
+)
+
+// trackingParamDenylist lists the exact query parameter names known to
+// carry tracking or session information rather than anything needed to
+// resolve or download the resource itself. utm_* params are matched by
+// prefix below rather than listed individually, since campaigns mint new
+// utm_content/utm_term values constantly.
+var trackingParamDenylist = []string{
+	"fbclid", "gclid", "msclkid",
+	"ref", "referrer",
+	"sessionid", "session_id", "sid",
+}
+
+// isTrackingParam reports whether key is a known tracking/session
+// parameter, per trackingParamDenylist and the utm_ prefix.
+func isTrackingParam(key string) bool {
+	key = strings.ToLower(key)
+	if strings.HasPrefix(key, "utm_") {
+		return true
+	}
+	for _, denied := range trackingParamDenylist {
+		if key == denied {
+			return true
+		}
+	}
+	return false
+}
+
+// stripTrackingParams removes known tracking/session query parameters from
+// rawURL (safe mode, see Client.SetSafeMode), leaving every other
+// parameter - including anything a download actually needs, like an
+// access token - untouched. Malformed URLs are returned unchanged rather
+// than erroring, since this only ever runs as a privacy nice-to-have
+// alongside normal URL resolution.
+func stripTrackingParams(rawURL string) string {
+	if rawURL == "" {
+		return rawURL
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.RawQuery == "" {
+		return rawURL
+	}
+
+	query := parsed.Query()
+	changed := false
+	for key := range query {
+		if isTrackingParam(key) {
+			query.Del(key)
+			changed = true
+		}
+	}
+	if !changed {
+		return rawURL
+	}
+
+	parsed.RawQuery = query.Encode()
+	return parsed.String()
+}
@@ -0,0 +1,32 @@
+package zlibrary
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStripTrackingParamsRemovesKnownParams(t *testing.T) {
+	in := "https://example.com/book/1?utm_source=newsletter&utm_campaign=x&fbclid=abc&token=keepme"
+	got := stripTrackingParams(in)
+
+	if strings.Contains(got, "utm_") || strings.Contains(got, "fbclid") {
+		t.Errorf("stripTrackingParams(%q) = %q, still has tracking params", in, got)
+	}
+	if !strings.Contains(got, "token=keepme") {
+		t.Errorf("stripTrackingParams(%q) = %q, dropped a non-tracking param", in, got)
+	}
+}
+
+func TestStripTrackingParamsLeavesCleanURLUnchanged(t *testing.T) {
+	in := "https://example.com/dl/1?key=abc123"
+	if got := stripTrackingParams(in); got != in {
+		t.Errorf("stripTrackingParams(%q) = %q, want unchanged", in, got)
+	}
+}
+
+func TestStripTrackingParamsNoQueryUnchanged(t *testing.T) {
+	in := "https://example.com/book/1"
+	if got := stripTrackingParams(in); got != in {
+		t.Errorf("stripTrackingParams(%q) = %q, want unchanged", in, got)
+	}
+}
@@ -0,0 +1,193 @@
+package zlibrary
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// SearchZLibrary searches the default mirror for query and returns the
+// results found on the given 1-indexed page. It is a thin wrapper around
+// SearchZLibraryStream that drains the channel into a slice - unless
+// mirror racing is enabled (see SetMirrorRacing), in which case it races
+// the primary against a configured candidate mirror instead.
+func (c *Client) SearchZLibrary(ctx context.Context, query string, page int) ([]BookSearchResult, error) {
+	query, err := normalizeSearchQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.raceCandidates()) >= 2 {
+		return c.searchRacingMirrors(ctx, query, page)
+	}
+	return c.searchSingleMirror(ctx, c, query, page)
+}
+
+// StreamResult is one item delivered by SearchZLibraryStream: either a
+// parsed BookSearchResult, or a terminal Err once the page could not be
+// fetched or parsed. The channel is always closed after an Err, if any.
+type StreamResult struct {
+	BookSearchResult
+	Err error
+}
+
+// SearchZLibraryStream searches like SearchZLibrary, but emits each parsed
+// book-item on the returned channel as soon as it's available instead of
+// waiting for the whole page, so a UI can populate rows progressively on
+// slow connections. The channel is closed once the page is fully parsed or
+// an error occurs.
+func (c *Client) SearchZLibraryStream(ctx context.Context, query string, page int) <-chan StreamResult {
+	out := make(chan StreamResult)
+
+	query, err := normalizeSearchQuery(query)
+	if err != nil {
+		go func() {
+			defer close(out)
+			out <- StreamResult{Err: err}
+		}()
+		return out
+	}
+
+	go func() {
+		defer close(out)
+
+		searchURL := fmt.Sprintf("%s/s/%s?page=%d", c.BaseURL(), url.PathEscape(query), page)
+
+		doc, err := c.fetchDocument(ctx, http.MethodGet, searchURL, nil)
+		if err != nil {
+			out <- StreamResult{Err: fmt.Errorf("zlibrary: search request: %w", err)}
+			return
+		}
+
+		items := doc.Find("div.book-item, z-bookcard")
+		total := items.Length()
+
+		items.EachWithBreak(func(i int, item *goquery.Selection) bool {
+			result := c.parseSearchItem(item)
+			c.notifySearchParseProgress(i+1, total)
+			if result.Title == "" {
+				// Not a real result - likely an ad slot or a malformed
+				// row in the listing. Skip it rather than showing a
+				// blank title in the results table.
+				return true
+			}
+
+			select {
+			case out <- StreamResult{BookSearchResult: result}:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+
+	return out
+}
+
+// SearchRedirect describes a search whose query resolved straight to a
+// book's details page - e.g. an exact ISBN or a uniquely-matching title -
+// instead of a results listing. A caller gets this back alongside an empty
+// results slice, so it can send the user straight to the book rather than
+// rendering a results list that has nothing to show.
+type SearchRedirect struct {
+	DetailsURL string
+	Title      string
+}
+
+// SearchZLibraryWithSuggestion works like SearchZLibrary, but additionally
+// returns the site's "did you mean" rewrite suggestion when the results
+// page shows one - typically alongside zero direct results for a mistyped
+// query - and a SearchRedirect when the query resolved straight to a book's
+// details page instead of a listing. At most one of the suggestion and the
+// redirect is ever non-nil.
+func (c *Client) SearchZLibraryWithSuggestion(ctx context.Context, query string, page int) ([]BookSearchResult, *string, *SearchRedirect, error) {
+	query, err := normalizeSearchQuery(query)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	searchURL := fmt.Sprintf("%s/s/%s?page=%d", c.BaseURL(), url.PathEscape(query), page)
+
+	doc, finalURL, err := c.fetchDocumentWithURL(ctx, http.MethodGet, searchURL, nil)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("zlibrary: search request: %w", err)
+	}
+
+	items := doc.Find("div.book-item, z-bookcard")
+	total := items.Length()
+
+	if total == 0 {
+		if redirect := parseSearchRedirect(doc, finalURL); redirect != nil {
+			return nil, nil, redirect, nil
+		}
+	}
+
+	var results []BookSearchResult
+	items.Each(func(i int, item *goquery.Selection) {
+		result := c.parseSearchItem(item)
+		if result.Title != "" {
+			results = append(results, result)
+		}
+		c.notifySearchParseProgress(i+1, total)
+	})
+
+	return results, parseSearchSuggestion(doc), nil, nil
+}
+
+// parseSearchRedirect reports whether doc is a book's details page rather
+// than a results listing - i.e. the search was redirected straight to a
+// book - returning the SearchRedirect to report back if so, or nil if doc
+// still looks like an ordinary (if empty) results page.
+func parseSearchRedirect(doc *goquery.Document, finalURL string) *SearchRedirect {
+	title := strings.TrimSpace(doc.Find("h1[itemprop=name]").First().Text())
+	if title == "" {
+		return nil
+	}
+	return &SearchRedirect{DetailsURL: finalURL, Title: title}
+}
+
+// parseSearchSuggestion extracts the site's "did you mean X" rewrite
+// suggestion from a search results page, when it shows one.
+func parseSearchSuggestion(doc *goquery.Document) *string {
+	text := strings.TrimSpace(doc.Find(".didYouMean a, .didYouMean .suggestion").First().Text())
+	if text == "" {
+		return nil
+	}
+	return &text
+}
+
+func (c *Client) parseSearchItem(item *goquery.Selection) BookSearchResult {
+	// Each of these is looked up once and reused, rather than re-running
+	// the same selector twice - this function runs once per result, so on
+	// a full page of a few hundred items the redundant traversals add up.
+	titleLink := item.Find("a.book-title").First()
+	img := item.Find("img").First()
+
+	detailsURL, _ := titleLink.Attr("href")
+	coverURL, _ := img.Attr("data-src")
+	if coverURL == "" {
+		coverURL, _ = img.Attr("src")
+	}
+	coverURL = c.resolveURL(coverURL)
+
+	authors := c.parseAuthors(item.Find(".authors").First())
+
+	return BookSearchResult{
+		Source:     SourceName,
+		ID:         strings.TrimPrefix(detailsURL, "/book/"),
+		Title:      strings.TrimSpace(titleLink.Text()),
+		Author:     joinAuthors(authors),
+		Authors:    authors,
+		Year:       strings.TrimSpace(item.Find(".property_year .property_value").First().Text()),
+		Language:   strings.TrimSpace(item.Find(".property_language .property_value").First().Text()),
+		Format:     strings.TrimSpace(item.Find(".property_extension .property_value").First().Text()),
+		SizeText:   strings.TrimSpace(item.Find(".property_size .property_value").First().Text()),
+		Rating:     strings.TrimSpace(item.Find(".book-rating").First().Text()),
+		CoverURL:   coverURL,
+		DetailsURL: detailsURL,
+	}
+}
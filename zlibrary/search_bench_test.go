@@ -0,0 +1,50 @@
+package zlibrary
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// syntheticSearchPage builds an HTML page with n book-item rows, enough
+// like the real listing to exercise parseSearchItem end to end.
+func syntheticSearchPage(n int) string {
+	var b strings.Builder
+	b.WriteString("<html><body>")
+	for i := 0; i < n; i++ {
+		b.WriteString(`<div class="book-item">
+			<a class="book-title" href="/book/12345/abcde/some-title.html">Some Title</a>
+			<div class="authors">Some Author</div>
+			<div class="property_year"><div class="property_value">2020</div></div>
+			<div class="property_language"><div class="property_value">English</div></div>
+			<div class="property_extension"><div class="property_value">EPUB</div></div>
+			<div class="property_size"><div class="property_value">1 MB</div></div>
+			<img data-src="/covers/123.jpg">
+		</div>`)
+	}
+	b.WriteString("</body></html>")
+	return b.String()
+}
+
+func BenchmarkParseSearchPage(b *testing.B) {
+	html := syntheticSearchPage(500)
+	c := NewClient()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		var results []BookSearchResult
+		doc.Find("div.book-item, z-bookcard").Each(func(_ int, item *goquery.Selection) {
+			results = append(results, c.parseSearchItem(item))
+		})
+
+		if len(results) != 500 {
+			b.Fatalf("got %d results, want 500", len(results))
+		}
+	}
+}
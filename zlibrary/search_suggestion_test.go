@@ -0,0 +1,85 @@
+package zlibrary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSearchZLibraryWithSuggestionParsesDidYouMean(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><body>
+			<div class="didYouMean">Did you mean <a href="/s/dune">dune</a>?</div>
+		</body></html>`))
+	}))
+	defer server.Close()
+
+	c := NewClient()
+	c.SetBaseURL(server.URL)
+
+	results, suggestion, _, err := c.SearchZLibraryWithSuggestion(context.Background(), "dun", 1)
+	if err != nil {
+		t.Fatalf("SearchZLibraryWithSuggestion: %v", err)
+	}
+	if len(results) != 0 {
+		t.Errorf("got %d results, want 0", len(results))
+	}
+	if suggestion == nil || strings.TrimSpace(*suggestion) != "dune" {
+		t.Errorf("suggestion = %v, want \"dune\"", suggestion)
+	}
+}
+
+func TestSearchZLibraryWithSuggestionNilWhenAbsent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><body></body></html>`))
+	}))
+	defer server.Close()
+
+	c := NewClient()
+	c.SetBaseURL(server.URL)
+
+	_, suggestion, _, err := c.SearchZLibraryWithSuggestion(context.Background(), "dune", 1)
+	if err != nil {
+		t.Fatalf("SearchZLibraryWithSuggestion: %v", err)
+	}
+	if suggestion != nil {
+		t.Errorf("suggestion = %v, want nil", *suggestion)
+	}
+}
+
+func TestSearchZLibraryWithSuggestionReportsRedirectToBookPage(t *testing.T) {
+	var mux http.ServeMux
+	mux.HandleFunc("/s/9780441013593", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/book/12345/abcde/dune.html", http.StatusFound)
+	})
+	mux.HandleFunc("/book/12345/abcde/dune.html", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><body><h1 itemprop="name">Dune</h1></body></html>`))
+	})
+	server := httptest.NewServer(&mux)
+	defer server.Close()
+
+	c := NewClient()
+	c.SetBaseURL(server.URL)
+
+	results, suggestion, redirect, err := c.SearchZLibraryWithSuggestion(context.Background(), "9780441013593", 1)
+	if err != nil {
+		t.Fatalf("SearchZLibraryWithSuggestion: %v", err)
+	}
+	if len(results) != 0 {
+		t.Errorf("got %d results, want 0", len(results))
+	}
+	if suggestion != nil {
+		t.Errorf("suggestion = %v, want nil", *suggestion)
+	}
+	if redirect == nil {
+		t.Fatal("redirect = nil, want a SearchRedirect")
+	}
+	if redirect.Title != "Dune" {
+		t.Errorf("redirect.Title = %q, want \"Dune\"", redirect.Title)
+	}
+	if !strings.Contains(redirect.DetailsURL, "/book/12345/abcde/dune.html") {
+		t.Errorf("redirect.DetailsURL = %q, want it to point at the book page", redirect.DetailsURL)
+	}
+}
@@ -0,0 +1,22 @@
+package zlibrary
+
+// SetSearchParseProgressCallback registers a function called while a
+// search results page is being parsed, with how many of the page's items
+// have been parsed so far and the total found on the page - so a caller
+// (typically the UI) can show progress through a large page instead of the
+// search just appearing to hang until it's fully parsed. Passing nil
+// disables the callback.
+func (c *Client) SetSearchParseProgressCallback(fn func(parsed, total int)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.searchParseProgress = fn
+}
+
+func (c *Client) notifySearchParseProgress(parsed, total int) {
+	c.mu.Lock()
+	cb := c.searchParseProgress
+	c.mu.Unlock()
+	if cb != nil {
+		cb(parsed, total)
+	}
+}
@@ -0,0 +1,82 @@
+package zlibrary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSearchZLibraryWithSuggestionReportsParseProgress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(syntheticSearchPage(5)))
+	}))
+	defer server.Close()
+
+	c := NewClient()
+	c.SetBaseURL(server.URL)
+
+	var calls [][2]int
+	c.SetSearchParseProgressCallback(func(parsed, total int) {
+		calls = append(calls, [2]int{parsed, total})
+	})
+
+	results, _, _, err := c.SearchZLibraryWithSuggestion(context.Background(), "dune", 1)
+	if err != nil {
+		t.Fatalf("SearchZLibraryWithSuggestion: %v", err)
+	}
+	if len(results) != 5 {
+		t.Fatalf("got %d results, want 5", len(results))
+	}
+	if len(calls) != 5 {
+		t.Fatalf("got %d progress calls, want 5", len(calls))
+	}
+	for i, call := range calls {
+		if call[0] != i+1 || call[1] != 5 {
+			t.Errorf("call %d = %v, want [%d 5]", i, call, i+1)
+		}
+	}
+}
+
+func TestSearchZLibraryStreamReportsParseProgress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(syntheticSearchPage(3)))
+	}))
+	defer server.Close()
+
+	c := NewClient()
+	c.SetBaseURL(server.URL)
+
+	var calls [][2]int
+	c.SetSearchParseProgressCallback(func(parsed, total int) {
+		calls = append(calls, [2]int{parsed, total})
+	})
+
+	var n int
+	for result := range c.SearchZLibraryStream(context.Background(), "dune", 1) {
+		if result.Err != nil {
+			t.Fatalf("SearchZLibraryStream: %v", result.Err)
+		}
+		n++
+	}
+	if n != 3 {
+		t.Fatalf("got %d results, want 3", n)
+	}
+	if len(calls) != 3 {
+		t.Fatalf("got %d progress calls, want 3", len(calls))
+	}
+	for i, call := range calls {
+		if call[0] != i+1 || call[1] != 3 {
+			t.Errorf("call %d = %v, want [%d 3]", i, call, i+1)
+		}
+	}
+}
+
+func TestSetSearchParseProgressCallbackNilDisables(t *testing.T) {
+	c := NewClient()
+	c.SetSearchParseProgressCallback(func(parsed, total int) {
+		t.Fatal("callback should not be called once disabled")
+	})
+	c.SetSearchParseProgressCallback(nil)
+	c.notifySearchParseProgress(1, 1)
+}
@@ -0,0 +1,115 @@
+package zlibrary
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// maxSeriesPages bounds how many pages GetSeriesBooks will follow via
+// "next page" links, as a guard against a malformed or self-referential
+// pagination link looping forever.
+const maxSeriesPages = 50
+
+// GetSeriesBooks fetches every volume listed on a series' page, following
+// "next page" links until the series is exhausted or maxSeriesPages is
+// reached. Volumes are returned ordered by their position in the series
+// where stated; volumes with no stated position sort after those that
+// have one, in the order they were listed. The second return value is the
+// series' display name, read from the first page.
+func (c *Client) GetSeriesBooks(ctx context.Context, seriesURL string) ([]BookSearchResult, string, error) {
+	var (
+		volumes    []seriesVolume
+		seriesName string
+		nextURL    = c.resolveURL(seriesURL)
+	)
+
+	for page := 0; nextURL != "" && page < maxSeriesPages; page++ {
+		doc, err := c.fetchDocument(ctx, http.MethodGet, nextURL, nil)
+		if err != nil {
+			return nil, "", fmt.Errorf("zlibrary: series request: %w", err)
+		}
+
+		if seriesName == "" {
+			seriesName = strings.TrimSpace(doc.Find("h1, .series-title").First().Text())
+		}
+
+		doc.Find("div.book-item, z-bookcard").Each(func(_ int, item *goquery.Selection) {
+			volumes = append(volumes, c.parseSeriesItem(item))
+		})
+
+		nextURL = c.nextSeriesPageURL(doc)
+	}
+
+	sortSeriesVolumes(volumes)
+
+	results := make([]BookSearchResult, len(volumes))
+	for i, v := range volumes {
+		results[i] = v.BookSearchResult
+	}
+
+	return results, seriesName, nil
+}
+
+// seriesVolume pairs a parsed search result with its position in the
+// series, kept separately since BookSearchResult itself has no index
+// field - only BookDetails does.
+type seriesVolume struct {
+	BookSearchResult
+	index *string
+}
+
+func (c *Client) parseSeriesItem(item *goquery.Selection) seriesVolume {
+	result := c.parseSearchItem(item)
+
+	indexText := strings.TrimSpace(item.Find(".series-index, .book-index").First().Text())
+	var index *string
+	if n := firstNumber(indexText); n != "" {
+		index = &n
+	}
+
+	return seriesVolume{BookSearchResult: result, index: index}
+}
+
+// sortSeriesVolumes orders vols by numeric index ascending, leaving
+// volumes with no stated index after all of those that have one, in
+// whatever relative order they were already in.
+func sortSeriesVolumes(vols []seriesVolume) {
+	sort.SliceStable(vols, func(i, j int) bool {
+		vi, iOK := seriesIndexValue(vols[i].index)
+		vj, jOK := seriesIndexValue(vols[j].index)
+		if iOK != jOK {
+			return iOK
+		}
+		if !iOK {
+			return false
+		}
+		return vi < vj
+	})
+}
+
+func seriesIndexValue(index *string) (int, bool) {
+	if index == nil {
+		return 0, false
+	}
+	n, err := strconv.Atoi(*index)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// nextSeriesPageURL returns the resolved URL of a series listing's next
+// page, or "" if there isn't one.
+func (c *Client) nextSeriesPageURL(doc *goquery.Document) string {
+	href, ok := doc.Find(`a.next, .pagination a[rel="next"]`).First().Attr("href")
+	if !ok || href == "" {
+		return ""
+	}
+	return c.resolveURL(href)
+}
@@ -0,0 +1,24 @@
+package zlibrary
+
+import "testing"
+
+func TestSortSeriesVolumes(t *testing.T) {
+	idx := func(s string) *string { return &s }
+
+	vols := []seriesVolume{
+		{BookSearchResult: BookSearchResult{Title: "No Index A"}, index: nil},
+		{BookSearchResult: BookSearchResult{Title: "Book 3"}, index: idx("3")},
+		{BookSearchResult: BookSearchResult{Title: "Book 1"}, index: idx("1")},
+		{BookSearchResult: BookSearchResult{Title: "No Index B"}, index: nil},
+		{BookSearchResult: BookSearchResult{Title: "Book 2"}, index: idx("2")},
+	}
+
+	sortSeriesVolumes(vols)
+
+	want := []string{"Book 1", "Book 2", "Book 3", "No Index A", "No Index B"}
+	for i, title := range want {
+		if vols[i].Title != title {
+			t.Errorf("position %d = %q, want %q", i, vols[i].Title, title)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package zlibrary
+
+import "testing"
+
+func TestFirstNumber(t *testing.T) {
+	cases := map[string]string{
+		"412 / 430": "412",
+		"412":        "412",
+		"":           "",
+		"no digits":  "",
+	}
+	for in, want := range cases {
+		if got := firstNumber(in); got != want {
+			t.Errorf("firstNumber(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestParseSeriesProperty(t *testing.T) {
+	cases := []struct {
+		in        string
+		wantName  string
+		wantIndex string // empty means nil
+	}{
+		{"Mistborn (Book 3)", "Mistborn", "3"},
+		{"Mistborn #3", "Mistborn", "3"},
+		{"Discworld #5", "Discworld", "5"},
+		{"Discworld Vol. 2", "Discworld", "2"},
+		{"Discworld Volume 2", "Discworld", "2"},
+		{"Discworld Vol. 2.5", "Discworld", "2.5"},
+		{"Mistborn", "Mistborn", ""},
+		{"", "", ""},
+	}
+
+	for _, tc := range cases {
+		name, index := parseSeriesProperty(tc.in)
+		if name != tc.wantName {
+			t.Errorf("parseSeriesProperty(%q) name = %q, want %q", tc.in, name, tc.wantName)
+		}
+		if tc.wantIndex == "" {
+			if index != nil {
+				t.Errorf("parseSeriesProperty(%q) index = %q, want nil", tc.in, *index)
+			}
+			continue
+		}
+		if index == nil || *index != tc.wantIndex {
+			t.Errorf("parseSeriesProperty(%q) index = %v, want %q", tc.in, index, tc.wantIndex)
+		}
+	}
+}
+
+func TestParseSeriesIndexNumeric(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	cases := []struct {
+		in   *string
+		want *float64
+	}{
+		{nil, nil},
+		{str("3"), floatPtr(3)},
+		{str("2.5"), floatPtr(2.5)},
+		{str("3a"), nil},
+		{str(""), nil},
+	}
+
+	for _, tc := range cases {
+		got := parseSeriesIndexNumeric(tc.in)
+		switch {
+		case tc.want == nil && got != nil:
+			t.Errorf("parseSeriesIndexNumeric(%v) = %v, want nil", tc.in, *got)
+		case tc.want != nil && (got == nil || *got != *tc.want):
+			t.Errorf("parseSeriesIndexNumeric(%v) = %v, want %v", tc.in, got, *tc.want)
+		}
+	}
+}
+
+func floatPtr(f float64) *float64 { return &f }
@@ -0,0 +1,105 @@
+package zlibrary
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// statsLatencyWindow caps how many recent latencies ClientStats percentiles
+// are computed over, so a long-running session doesn't grow this
+// unboundedly.
+const statsLatencyWindow = 500
+
+// ClientStats is a point-in-time snapshot of everything a StatsCollector
+// has observed.
+type ClientStats struct {
+	TotalRequests int
+	ErrorCount    int
+	ErrorRate     float64
+	InFlight      int32
+	LatencyP50    time.Duration
+	LatencyP95    time.Duration
+	LatencyP99    time.Duration
+}
+
+// StatsCollector is a ready-made request/response hook pair (see
+// SetRequestHook/SetResponseHook) that tracks request volume, error rate,
+// in-flight count, and latency percentiles, for a UI metrics panel or a
+// tiny "requests in flight" spinner. Safe for concurrent use.
+type StatsCollector struct {
+	inFlight int32
+
+	mu        sync.Mutex
+	total     int
+	errors    int
+	latencies []time.Duration
+}
+
+// NewStatsCollector returns a StatsCollector ready to back
+// SetRequestHook/SetResponseHook.
+func NewStatsCollector() *StatsCollector {
+	return &StatsCollector{}
+}
+
+// RequestHook returns the RequestHook to pass to SetRequestHook.
+func (s *StatsCollector) RequestHook() RequestHook {
+	return func(req *http.Request) {
+		atomic.AddInt32(&s.inFlight, 1)
+	}
+}
+
+// ResponseHook returns the ResponseHook to pass to SetResponseHook.
+func (s *StatsCollector) ResponseHook() ResponseHook {
+	return func(req *http.Request, resp *http.Response, d time.Duration, err error) {
+		atomic.AddInt32(&s.inFlight, -1)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.total++
+		if err != nil {
+			s.errors++
+		}
+		s.latencies = append(s.latencies, d)
+		if len(s.latencies) > statsLatencyWindow {
+			s.latencies = s.latencies[len(s.latencies)-statsLatencyWindow:]
+		}
+	}
+}
+
+// ClientStats returns a snapshot of everything observed so far.
+func (s *StatsCollector) ClientStats() ClientStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := ClientStats{
+		TotalRequests: s.total,
+		ErrorCount:    s.errors,
+		InFlight:      atomic.LoadInt32(&s.inFlight),
+	}
+	if s.total > 0 {
+		stats.ErrorRate = float64(s.errors) / float64(s.total)
+	}
+
+	sorted := append([]time.Duration(nil), s.latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	stats.LatencyP50 = latencyPercentile(sorted, 0.50)
+	stats.LatencyP95 = latencyPercentile(sorted, 0.95)
+	stats.LatencyP99 = latencyPercentile(sorted, 0.99)
+	return stats
+}
+
+// latencyPercentile returns the p-th percentile (0-1) of sorted, which must
+// already be sorted ascending.
+func latencyPercentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(p * float64(len(sorted)))
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
@@ -0,0 +1,45 @@
+package zlibrary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStatsCollectorTracksCountsAndErrors(t *testing.T) {
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer ok.Close()
+
+	c := NewClient()
+	stats := NewStatsCollector()
+	c.SetRequestHook(stats.RequestHook())
+	c.SetResponseHook(stats.ResponseHook())
+
+	for i := 0; i < 3; i++ {
+		resp, err := c.MakeRequest(context.Background(), http.MethodGet, ok.URL, nil)
+		if err != nil {
+			t.Fatalf("MakeRequest: %v", err)
+		}
+		resp.Body.Close()
+	}
+	if _, err := c.MakeRequest(context.Background(), http.MethodGet, "http://127.0.0.1:0", nil); err == nil {
+		t.Fatal("MakeRequest err = nil, want a transport error")
+	}
+
+	got := stats.ClientStats()
+	if got.TotalRequests != 4 {
+		t.Errorf("TotalRequests = %d, want 4", got.TotalRequests)
+	}
+	if got.ErrorCount != 1 {
+		t.Errorf("ErrorCount = %d, want 1", got.ErrorCount)
+	}
+	if got.InFlight != 0 {
+		t.Errorf("InFlight = %d, want 0 after every request finished", got.InFlight)
+	}
+	if got.ErrorRate != 0.25 {
+		t.Errorf("ErrorRate = %v, want 0.25", got.ErrorRate)
+	}
+}
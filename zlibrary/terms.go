@@ -0,0 +1,52 @@
+package zlibrary
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Term is one entry from the site's popular-searches listing.
+type Term struct {
+	Name  string
+	URL   string
+	Count int
+}
+
+// GetPopularTerms fetches the site's popular-searches page and parses the
+// listed terms. A markup change here should only ever degrade to an empty
+// slice, never surface an error that would make a caller think search
+// itself is broken - callers that want a discovery sidebar can just treat
+// an empty result as "nothing to show".
+func (c *Client) GetPopularTerms(ctx context.Context) ([]Term, error) {
+	doc, err := c.fetchDocument(ctx, http.MethodGet, c.BaseURL()+"/popular-searches", nil)
+	if err != nil {
+		return nil, fmt.Errorf("zlibrary: popular terms request: %w", err)
+	}
+
+	var terms []Term
+	doc.Find(".popular-searches a, .most-popular a").Each(func(_ int, a *goquery.Selection) {
+		name := strings.TrimSpace(a.Text())
+		if name == "" {
+			return
+		}
+		href, _ := a.Attr("href")
+
+		count := 0
+		if text, ok := a.Attr("data-count"); ok {
+			count, _ = strconv.Atoi(strings.TrimSpace(text))
+		}
+
+		terms = append(terms, Term{
+			Name:  name,
+			URL:   c.resolveURL(href),
+			Count: count,
+		})
+	})
+
+	return terms, nil
+}
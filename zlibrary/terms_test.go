@@ -0,0 +1,53 @@
+package zlibrary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetPopularTermsParsesListing(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<div class="popular-searches">
+			<a href="/s/fiction" data-count="120">fiction</a>
+			<a href="/s/history" data-count="45">history</a>
+		</div>`))
+	}))
+	defer srv.Close()
+
+	c := NewClient()
+	c.SetBaseURL(srv.URL)
+
+	terms, err := c.GetPopularTerms(context.Background())
+	if err != nil {
+		t.Fatalf("GetPopularTerms: %v", err)
+	}
+	if len(terms) != 2 {
+		t.Fatalf("got %d terms, want 2", len(terms))
+	}
+	if terms[0].Name != "fiction" || terms[0].Count != 120 {
+		t.Errorf("terms[0] = %+v, want fiction/120", terms[0])
+	}
+	if terms[1].Name != "history" || terms[1].Count != 45 {
+		t.Errorf("terms[1] = %+v, want history/45", terms[1])
+	}
+}
+
+func TestGetPopularTermsEmptyWhenNoMarkup(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><body>nothing here</body></html>`))
+	}))
+	defer srv.Close()
+
+	c := NewClient()
+	c.SetBaseURL(srv.URL)
+
+	terms, err := c.GetPopularTerms(context.Background())
+	if err != nil {
+		t.Fatalf("GetPopularTerms: %v", err)
+	}
+	if len(terms) != 0 {
+		t.Errorf("got %d terms, want 0", len(terms))
+	}
+}
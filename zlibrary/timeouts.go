@@ -0,0 +1,94 @@
+package zlibrary
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+)
+
+// defaultConnectTimeout and defaultResponseHeaderTimeout bound the dial/TLS
+// handshake and the wait for response headers respectively - both kept
+// short, since a mirror that can't even open a connection or start
+// responding within a few seconds isn't going to recover mid-request.
+// defaultOverallTimeout bounds the whole exchange including body read; a
+// transfer that runs past it is cut off, so a large download goes through
+// SetOverallTimeout(0) instead.
+const (
+	defaultConnectTimeout        = 10 * time.Second
+	defaultResponseHeaderTimeout = 15 * time.Second
+	defaultOverallTimeout        = 30 * time.Second
+)
+
+// newTransport builds the *http.Transport used by every Client, wiring its
+// dial and response-header timeouts to the given bounds so a stalled
+// connect fails fast even when the client's overall timeout is set long
+// enough to cover a slow body read.
+func newTransport(connectTimeout, responseHeaderTimeout time.Duration) *http.Transport {
+	return &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			ctx, cancel := context.WithTimeout(ctx, connectTimeout)
+			defer cancel()
+			return dialContext(ctx, network, addr)
+		},
+		TLSHandshakeTimeout:   connectTimeout,
+		ResponseHeaderTimeout: responseHeaderTimeout,
+	}
+}
+
+// dialContext opens the raw connection beneath every transport newTransport
+// builds; tests swap in a dialer that never connects.
+var dialContext = (&net.Dialer{}).DialContext
+
+// SetConnectTimeout overrides how long dialing (including TLS handshake)
+// may take before a request fails, independent of the overall timeout set
+// via SetOverallTimeout. Zero or negative resets it to the default.
+func (c *Client) SetConnectTimeout(d time.Duration) {
+	if d <= 0 {
+		d = defaultConnectTimeout
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.connectTimeout = d
+	c.httpClient.Transport = newTransport(c.connectTimeout, c.responseHeaderTimeoutLocked())
+}
+
+// SetResponseHeaderTimeout overrides how long a request may wait for
+// response headers once the connection is established. Zero or negative
+// resets it to the default.
+func (c *Client) SetResponseHeaderTimeout(d time.Duration) {
+	if d <= 0 {
+		d = defaultResponseHeaderTimeout
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responseHeaderTimeout = d
+	c.httpClient.Transport = newTransport(c.connectTimeoutLocked(), c.responseHeaderTimeout)
+}
+
+// SetOverallTimeout overrides the total time a request - including
+// streaming its body - may take. Zero disables the overall timeout
+// entirely, leaving only the connect and response-header timeouts in
+// effect; this is the setting to use before a large download so a slow
+// but steady transfer isn't cut off partway through.
+func (c *Client) SetOverallTimeout(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.httpClient.Timeout = d
+}
+
+// connectTimeoutLocked and responseHeaderTimeoutLocked return the
+// configured timeout, or its default if unset. Callers must hold c.mu.
+func (c *Client) connectTimeoutLocked() time.Duration {
+	if c.connectTimeout <= 0 {
+		return defaultConnectTimeout
+	}
+	return c.connectTimeout
+}
+
+func (c *Client) responseHeaderTimeoutLocked() time.Duration {
+	if c.responseHeaderTimeout <= 0 {
+		return defaultResponseHeaderTimeout
+	}
+	return c.responseHeaderTimeout
+}
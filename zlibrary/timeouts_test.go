@@ -0,0 +1,71 @@
+package zlibrary
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// slowDialer never completes a connection, standing in for a mirror that's
+// unreachable at the network level rather than merely slow to respond.
+type slowDialer struct{ delay time.Duration }
+
+func (d slowDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	timer := time.NewTimer(d.delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return net.Dial(network, addr)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func TestSetConnectTimeoutFailsFastOnStalledDial(t *testing.T) {
+	c := NewClient()
+	c.SetConnectTimeout(20 * time.Millisecond)
+	c.SetOverallTimeout(0) // a long-lived download shouldn't mask a stalled connect
+
+	orig := dialContext
+	dialContext = slowDialer{delay: time.Hour}.DialContext
+	defer func() { dialContext = orig }()
+
+	start := time.Now()
+	_, err := c.MakeRequest(context.Background(), http.MethodGet, "http://10.255.255.1/", nil)
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("MakeRequest() succeeded against a dialer that never connects")
+	}
+	if elapsed > 5*time.Second {
+		t.Errorf("MakeRequest() took %v, want it to fail quickly despite SetOverallTimeout(0)", elapsed)
+	}
+}
+
+func TestSetOverallTimeoutZeroDisablesOverallDeadline(t *testing.T) {
+	c := NewClient()
+	c.SetOverallTimeout(0)
+
+	c.mu.Lock()
+	timeout := c.httpClient.Timeout
+	c.mu.Unlock()
+
+	if timeout != 0 {
+		t.Errorf("httpClient.Timeout = %v, want 0", timeout)
+	}
+}
+
+func TestSetConnectTimeoutDefaultsOnNonPositive(t *testing.T) {
+	c := NewClient()
+	c.SetConnectTimeout(-1)
+
+	c.mu.Lock()
+	got := c.connectTimeoutLocked()
+	c.mu.Unlock()
+
+	if got != defaultConnectTimeout {
+		t.Errorf("connectTimeoutLocked() = %v, want default %v", got, defaultConnectTimeout)
+	}
+}
@@ -0,0 +1,42 @@
+package zlibrary
+
+import "math/rand"
+
+// userAgentProfile pairs a User-Agent string with the sec-ch-ua client hints
+// a real browser sending that UA would also send. Presenting a Chrome UA
+// without matching client hints is itself a signal used to flag bots.
+type userAgentProfile struct {
+	userAgent       string
+	secChUA         string
+	secChUAPlatform string
+}
+
+// userAgentPool is the small set of current desktop browser profiles that
+// rotation mode picks from.
+var userAgentPool = []userAgentProfile{
+	{
+		userAgent:       "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36",
+		secChUA:         `"Chromium";v="126", "Google Chrome";v="126", "Not-A.Brand";v="99"`,
+		secChUAPlatform: `"Windows"`,
+	},
+	{
+		userAgent:       "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36",
+		secChUA:         `"Chromium";v="126", "Google Chrome";v="126", "Not-A.Brand";v="99"`,
+		secChUAPlatform: `"macOS"`,
+	},
+	{
+		userAgent:       "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36",
+		secChUA:         `"Chromium";v="126", "Google Chrome";v="126", "Not-A.Brand";v="99"`,
+		secChUAPlatform: `"Linux"`,
+	},
+	{
+		// Firefox sends no sec-ch-ua headers at all, so this profile leaves
+		// them empty.
+		userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:127.0) Gecko/20100101 Firefox/127.0",
+	},
+}
+
+// pickUserAgentProfile returns a random profile from the pool.
+func pickUserAgentProfile() userAgentProfile {
+	return userAgentPool[rand.Intn(len(userAgentPool))]
+}